@@ -0,0 +1,7 @@
+data "netbird_group" "prod_db_access" {
+  id = "ch8i4ug6lnn4g9hqv7m0"
+}
+
+output "prod_db_access_peers" {
+  value = data.netbird_group.prod_db_access.peers[*].name
+}
@@ -0,0 +1,13 @@
+data "netbird_peers" "web" {
+  name = "web"
+}
+
+# The group containing exactly the web servers
+data "netbird_group_by_peers" "web" {
+  peer_ids = data.netbird_peers.web.peers[*].id
+  strict   = true
+}
+
+output "web_group_id" {
+  value = data.netbird_group_by_peers.web.id
+}
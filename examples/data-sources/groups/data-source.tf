@@ -0,0 +1,10 @@
+data "netbird_groups" "all" {}
+
+# Groups created in the dashboard or API, skipping groups synced from JWT claims
+data "netbird_groups" "manageable" {
+  issued = "api"
+}
+
+output "manageable_group_ids" {
+  value = { for group in data.netbird_groups.manageable.groups : group.id => group.name }
+}
@@ -0,0 +1,8 @@
+data "netbird_network" "this" {
+  id     = "somenetbirdnetworkid"
+  expand = true
+}
+
+output "network_resource_addresses" {
+  value = data.netbird_network.this.resource_details[*].address
+}
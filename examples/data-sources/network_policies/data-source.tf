@@ -0,0 +1,7 @@
+data "netbird_network_policies" "this" {
+  network_id = "somenetbirdnetworkid"
+}
+
+output "disabled_network_policies" {
+  value = [for policy in data.netbird_network_policies.this.policies : policy.name if !policy.enabled]
+}
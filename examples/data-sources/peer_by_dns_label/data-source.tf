@@ -0,0 +1,8 @@
+# The label can be the full domain name or only the part before the domain
+data "netbird_peer_by_dns_label" "gateway" {
+  dns_label = "gateway.netbird.cloud"
+}
+
+output "gateway_ip" {
+  value = data.netbird_peer_by_dns_label.gateway.ip
+}
@@ -1,3 +1,22 @@
 data "netbird_peers" "this" {
   name = "match-this-peer-name"
-}
\ No newline at end of file
+}
+
+# Peers running a NetBird version older than 0.40.0, which need upgrading
+data "netbird_peers" "outdated" {
+  max_version = "0.40.0"
+}
+
+# Approve every peer pending approval. Approved peers drop out of the data
+# source on the next run, so they must stay approved when their resource is
+# destroyed.
+data "netbird_peers" "pending" {
+  approval_required = true
+}
+
+resource "netbird_peer_approval" "pending" {
+  for_each = { for peer in data.netbird_peers.pending.peers : peer.id => peer }
+
+  peer_id           = each.key
+  revoke_on_destroy = false
+}
@@ -0,0 +1,7 @@
+data "netbird_policy_by_name" "default" {
+  name = "Default"
+}
+
+output "default_policy_rules" {
+  value = data.netbird_policy_by_name.default.rules
+}
@@ -0,0 +1,7 @@
+terraform {
+  required_providers {
+    netbird = {
+      source = "dockstudios/netbird"
+    }
+  }
+}
\ No newline at end of file
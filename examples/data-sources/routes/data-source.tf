@@ -0,0 +1,11 @@
+data "netbird_routes" "all" {}
+
+# Domain routes that are currently enabled
+data "netbird_routes" "domains" {
+  enabled      = true
+  network_type = "Domain"
+}
+
+output "routed_domains" {
+  value = flatten(data.netbird_routes.domains.routes[*].domains)
+}
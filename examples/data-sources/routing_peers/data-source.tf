@@ -0,0 +1,9 @@
+data "netbird_routing_peers" "this" {}
+
+output "total_routing_peers" {
+  value = data.netbird_routing_peers.this.total_routing_peers_count
+}
+
+output "networks_without_routing_peers" {
+  value = [for network in data.netbird_routing_peers.this.networks : network.name if network.routing_peers_count == 0]
+}
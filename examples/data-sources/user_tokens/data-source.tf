@@ -0,0 +1,11 @@
+data "netbird_user_tokens" "this" {
+  user_id = "d3b0f3d2-0b4c-4b6e-9a43-5f2e3c9a1b7d"
+}
+
+# Tokens expiring within the next 30 days
+output "expiring_tokens" {
+  value = [
+    for token in data.netbird_user_tokens.this.tokens : token.name
+    if timecmp(token.expiration_date, timeadd(plantimestamp(), "720h")) < 0
+  ]
+}
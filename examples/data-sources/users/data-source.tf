@@ -0,0 +1,16 @@
+# Collections for access reviews
+data "netbird_users" "admins" {
+  role = "admin"
+}
+
+data "netbird_users" "service_users" {
+  is_service_user = true
+}
+
+output "admin_emails" {
+  value = data.netbird_users.admins.users[*].email
+}
+
+output "service_user_ids" {
+  value = data.netbird_users.service_users.ids
+}
@@ -0,0 +1,32 @@
+locals {
+  database_address = "10.20.0.0/24"
+}
+
+resource "netbird_network_resource" "database" {
+  network_id  = netbird_network.this.id
+  name        = "database"
+  address     = local.database_address
+  enabled     = true
+  peer_groups = [netbird_group.databases.id]
+}
+
+resource "netbird_policy" "database" {
+  name    = "database"
+  enabled = true
+  rules = [
+    {
+      name          = "postgres"
+      enabled       = true
+      action        = "accept"
+      bidirectional = false
+      protocol      = "tcp"
+      ports         = ["5432"]
+      sources       = [netbird_group.developers.id]
+
+      destination_resource = {
+        id   = netbird_network_resource.database.id
+        type = provider::netbird::address_type(local.database_address) # "subnet"
+      }
+    }
+  ]
+}
@@ -0,0 +1,17 @@
+resource "netbird_policy" "web" {
+  name    = "web"
+  enabled = true
+  rules = [
+    {
+      name          = "web"
+      enabled       = true
+      action        = "accept"
+      bidirectional = false
+      protocol      = "tcp"
+      # ["80", "443", "8000", "8001", ..., "8010"]
+      ports        = provider::netbird::ports(["80", "443", "8000-8010"])
+      sources      = [netbird_group.clients.id]
+      destinations = [netbird_group.servers.id]
+    }
+  ]
+}
@@ -0,0 +1,18 @@
+# Attributes that are not set keep their current value
+resource "netbird_account_settings" "this" {
+  peer_login_expiration_enabled = true
+  peer_login_expiration         = 86400
+
+  peer_inactivity_expiration_enabled = true
+  peer_inactivity_expiration         = 3600
+
+  regular_users_view_blocked = true
+
+  # New peers wait for approval by an admin before they can connect
+  peer_approval_enabled = true
+
+  # Sync groups from the `groups` claim of the identity provider's JWTs
+  jwt_groups_enabled         = true
+  jwt_groups_claim_name      = "groups"
+  groups_propagation_enabled = true
+}
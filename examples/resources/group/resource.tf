@@ -1,3 +1,37 @@
 resource "netbird_group" "this" {
   name = "example-group"
-}
\ No newline at end of file
+}
+
+# peers_count is refreshed from NetBird on every plan, so it can guard
+# resources that are only useful once peers have joined the group.
+resource "netbird_group" "servers" {
+  name = "servers"
+}
+
+resource "netbird_policy" "ssh" {
+  name    = "ssh-to-servers"
+  enabled = true
+  rules = [
+    {
+      name          = "ssh"
+      enabled       = true
+      action        = "accept"
+      bidirectional = false
+      protocol      = "tcp"
+      ports         = ["22"]
+      sources       = [netbird_group.this.id]
+      destinations  = [netbird_group.servers.id]
+    }
+  ]
+
+  lifecycle {
+    precondition {
+      condition     = netbird_group.servers.peers_count > 0
+      error_message = "The servers group has no peers yet, enroll a server with a setup key first."
+    }
+  }
+}
+
+output "servers_peers_count" {
+  value = netbird_group.servers.peers_count
+}
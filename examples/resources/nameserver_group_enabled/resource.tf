@@ -0,0 +1,27 @@
+resource "netbird_group" "all" {
+  name = "all-peers"
+}
+
+resource "netbird_nameserver_group" "internal" {
+  name        = "internal"
+  peer_groups = [netbird_group.all.id]
+  domains     = ["corp.example.com"]
+  nameservers = [
+    {
+      ip      = "10.0.0.53"
+      ns_type = "udp"
+      port    = 53
+    },
+  ]
+
+  lifecycle {
+    # Managed by netbird_nameserver_group_enabled
+    ignore_changes = [enabled]
+  }
+}
+
+# Toggle the nameserver group, e.g. during maintenance of the DNS servers
+resource "netbird_nameserver_group_enabled" "internal" {
+  nameserver_group_id = netbird_nameserver_group.internal.id
+  enabled             = false
+}
@@ -0,0 +1,2 @@
+# Network resources are imported by their network ID and resource ID
+terraform import netbird_network_resource.this <network_id>/<resource_id>
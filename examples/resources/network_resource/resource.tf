@@ -21,4 +21,8 @@ resource "netbird_network_resource" "this" {
   address     = "example.com"
   peer_groups = netbird.group.this.id
   enabled     = true
-}
\ No newline at end of file
+}
+# How NetBird interpreted the address: host, subnet or domain
+output "resource_type" {
+  value = netbird_network_resource.this.type
+}
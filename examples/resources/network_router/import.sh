@@ -0,0 +1,2 @@
+# Routers are imported by their network ID and router ID
+terraform import netbird_network_router.this <network_id>/<router_id>
@@ -0,0 +1,2 @@
+# Peers are imported by their ID
+terraform import netbird_peer.database <peer_id>
@@ -0,0 +1,15 @@
+variable "peer_id" {
+  type        = string
+  description = "ID of the registered database server"
+}
+
+# Adopts the registered peer; attributes that are not set keep their
+# current value
+resource "netbird_peer" "database" {
+  id          = var.peer_id
+  name        = "database"
+  ssh_enabled = true
+
+  # Keep the server connected, regardless of the account default
+  inactivity_expiration_enabled = false
+}
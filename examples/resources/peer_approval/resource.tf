@@ -0,0 +1,11 @@
+variable "peer_id" {
+  type        = string
+  description = "ID of the instance that passed attestation"
+}
+
+resource "netbird_peer_approval" "instance" {
+  peer_id = var.peer_id
+
+  # Keep the peer approved when the pipeline tears down its state
+  revoke_on_destroy = false
+}
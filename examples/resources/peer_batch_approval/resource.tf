@@ -0,0 +1,8 @@
+variable "new_peer_ids" {
+  type        = list(string)
+  description = "IDs of the machines provisioned in this batch"
+}
+
+resource "netbird_peer_batch_approval" "batch" {
+  peer_ids = var.new_peer_ids
+}
@@ -26,4 +26,10 @@ resource "netbird_policy" "this" {
       destinations = [netbird_group.dest.id]
     }
   ]
-}
\ No newline at end of file
+
+  # Optional limits for each operation, defaults to the provider request timeout per API call
+  timeouts {
+    create = "1m"
+    update = "30s"
+  }
+}
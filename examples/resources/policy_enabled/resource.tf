@@ -0,0 +1,35 @@
+resource "netbird_group" "developers" {
+  name = "developers"
+}
+
+resource "netbird_group" "databases" {
+  name = "databases"
+}
+
+resource "netbird_policy" "database_access" {
+  name    = "database-access"
+  enabled = true
+  rules = [
+    {
+      name          = "postgres"
+      enabled       = true
+      action        = "accept"
+      bidirectional = false
+      protocol      = "tcp"
+      ports         = ["5432"]
+      sources       = [netbird_group.developers.id]
+      destinations  = [netbird_group.databases.id]
+    },
+  ]
+
+  lifecycle {
+    # Managed by netbird_policy_enabled
+    ignore_changes = [enabled]
+  }
+}
+
+# Cut off access, e.g. during maintenance of the databases
+resource "netbird_policy_enabled" "database_access" {
+  policy_id = netbird_policy.database_access.id
+  enabled   = false
+}
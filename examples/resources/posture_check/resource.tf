@@ -0,0 +1,48 @@
+resource "netbird_posture_check" "this" {
+  name        = "corporate-devices"
+  description = "Recent clients on the office network"
+
+  nb_version_check = {
+    min_version = "0.35.0"
+  }
+
+  os_version_check = {
+    darwin = {
+      min_version = "14.0"
+    }
+    windows = {
+      min_kernel_version = "10.0.19045"
+    }
+  }
+
+  peer_network_range_check = {
+    action = "allow"
+    ranges = ["192.168.0.0/16"]
+  }
+}
+
+# Requires the EDR agent to be running
+resource "netbird_posture_check" "edr" {
+  name = "edr-running"
+
+  process_check = {
+    processes = [{
+      linux_path   = "/opt/CrowdStrike/falcond"
+      mac_path     = "/Applications/Falcon.app/Contents/Resources/falcond"
+      windows_path = "C:\\Program Files\\CrowdStrike\\CSFalconService.exe"
+    }]
+  }
+}
+
+# Only allows peers in Germany or Paris
+resource "netbird_posture_check" "geo" {
+  name = "eu-offices"
+
+  geo_location_check = {
+    action = "allow"
+    locations = [
+      { country_code = "DE" },
+      { country_code = "FR", city_name = "Paris" },
+    ]
+  }
+}
@@ -0,0 +1,42 @@
+resource "netbird_group" "routers" {
+  name = "routers"
+}
+
+resource "netbird_group" "users" {
+  name = "users"
+}
+
+# Route a list of domains, resolved dynamically by the routing peers
+resource "netbird_route" "domains" {
+  network_id  = "saas"
+  description = "SaaS applications"
+  domains     = ["example.com", "*.example.org"]
+  keep_route  = true
+  peer_groups = [netbird_group.routers.id]
+  groups      = [netbird_group.users.id]
+  masquerade  = true
+  enabled     = true
+}
+
+# Route a network range
+resource "netbird_route" "office" {
+  network_id  = "office"
+  network     = "10.10.0.0/16"
+  peer_groups = [netbird_group.routers.id]
+  groups      = [netbird_group.users.id]
+  metric      = 100
+  masquerade  = true
+  enabled     = true
+}
+
+# Route all traffic through exit nodes, restricted by the policies whose
+# destination is one of the access control groups
+resource "netbird_route" "exit_node" {
+  network_id            = "exit-node"
+  network               = "0.0.0.0/0"
+  peer_groups           = [netbird_group.routers.id]
+  groups                = [netbird_group.users.id]
+  access_control_groups = [netbird_group.users.id]
+  masquerade            = true
+  enabled               = true
+}
@@ -0,0 +1,26 @@
+resource "netbird_group" "servers" {
+  name = "servers"
+}
+
+resource "netbird_setup_key" "this" {
+  name        = "servers"
+  type        = "reusable"
+  expires_in  = 2592000 # 30 days
+  auto_groups = [netbird_group.servers.id]
+}
+
+# Groups may also be referenced by name, for groups not managed by Terraform
+resource "netbird_setup_key" "laptops" {
+  name             = "laptops"
+  type             = "reusable"
+  ephemeral        = true
+  auto_group_names = ["All", "laptops"]
+}
+
+# The key is only returned by the API when it is created, so it is kept in state
+# from then on and never refreshed. Imported keys have no key value.
+# Retrieve it with `terraform output -raw setup_key` for `netbird up --setup-key`.
+output "setup_key" {
+  value     = netbird_setup_key.this.key
+  sensitive = true
+}
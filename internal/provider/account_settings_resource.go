@@ -0,0 +1,376 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	netbirdApi "github.com/netbirdio/netbird/management/server/http/api"
+)
+
+// accountSettingsID is the fixed ID of the account settings singleton.
+const accountSettingsID = "account-settings"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &AccountSettingsResource{}
+var _ resource.ResourceWithImportState = &AccountSettingsResource{}
+
+func NewAccountSettingsResource() resource.Resource {
+	return &AccountSettingsResource{}
+}
+
+// AccountSettingsResource defines the resource implementation.
+type AccountSettingsResource struct {
+	client ClientInterface
+}
+
+type AccountSettingsResourceModel struct {
+	ID                                 types.String `tfsdk:"id"`
+	AccountID                          types.String `tfsdk:"account_id"`
+	PeerLoginExpirationEnabled         types.Bool   `tfsdk:"peer_login_expiration_enabled"`
+	PeerLoginExpiration                types.Int64  `tfsdk:"peer_login_expiration"`
+	PeerInactivityExpirationEnabled    types.Bool   `tfsdk:"peer_inactivity_expiration_enabled"`
+	PeerInactivityExpiration           types.Int64  `tfsdk:"peer_inactivity_expiration"`
+	RegularUsersViewBlocked            types.Bool   `tfsdk:"regular_users_view_blocked"`
+	GroupsPropagationEnabled           types.Bool   `tfsdk:"groups_propagation_enabled"`
+	JwtGroupsEnabled                   types.Bool   `tfsdk:"jwt_groups_enabled"`
+	JwtGroupsClaimName                 types.String `tfsdk:"jwt_groups_claim_name"`
+	JwtAllowGroups                     types.List   `tfsdk:"jwt_allow_groups"`
+	DnsDomain                          types.String `tfsdk:"dns_domain"`
+	RoutingPeerDnsResolutionEnabled    types.Bool   `tfsdk:"routing_peer_dns_resolution_enabled"`
+	PeerApprovalEnabled                types.Bool   `tfsdk:"peer_approval_enabled"`
+	NetworkTrafficLogsEnabled          types.Bool   `tfsdk:"network_traffic_logs_enabled"`
+	NetworkTrafficPacketCounterEnabled types.Bool   `tfsdk:"network_traffic_packet_counter_enabled"`
+}
+
+func (r *AccountSettingsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_account_settings"
+}
+
+// peerApprovalEnabledDescription documents the effect of toggling peer approval on existing peers.
+const peerApprovalEnabledDescription = "(Cloud only) Enables or disables peer approval globally. When enabled, newly registered peers " +
+	"are pending until approved by an admin and can not access the network meanwhile. Disabling it lifts the requirement, " +
+	"so peers still pending approval are able to connect."
+
+// optionalBool returns a bool attribute which keeps the current account value when unset.
+func optionalBool(description string) schema.BoolAttribute {
+	return schema.BoolAttribute{
+		MarkdownDescription: description,
+		Optional:            true,
+		Computed:            true,
+		PlanModifiers: []planmodifier.Bool{
+			boolplanmodifier.UseStateForUnknown(),
+		},
+	}
+}
+
+func (r *AccountSettingsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Account settings resource. The account settings are a singleton: attributes that are not set keep their current value, " +
+			"and destroying the resource only removes it from the Terraform state. " +
+			"Import with any ID, e.g. `terraform import netbird_account_settings.this account-settings`.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Always `account-settings`",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"account_id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Account ID",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"peer_login_expiration_enabled": optionalBool("Enables or disables peer login expiration globally"),
+			"peer_login_expiration": schema.Int64Attribute{
+				MarkdownDescription: "Period of time after which peer login expires (seconds)",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"peer_inactivity_expiration_enabled": optionalBool("Enables or disables peer inactivity expiration globally"),
+			"peer_inactivity_expiration": schema.Int64Attribute{
+				MarkdownDescription: "Period of time of inactivity after which peer session expires (seconds)",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"regular_users_view_blocked": optionalBool("Block regular users from viewing parts of the system"),
+			"groups_propagation_enabled": optionalBool("Propagate new user auto groups to the peers that belong to the user"),
+			"jwt_groups_enabled":         optionalBool("Extract groups from the JWT claim and add them to account groups"),
+			"jwt_groups_claim_name": schema.StringAttribute{
+				MarkdownDescription: "Name of the JWT claim groups are extracted from",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"jwt_allow_groups": schema.ListAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "Groups whose users are allowed access",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"dns_domain": schema.StringAttribute{
+				MarkdownDescription: "Custom DNS domain for the account",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"routing_peer_dns_resolution_enabled":    optionalBool("Enables or disables DNS resolution on the routing peers"),
+			"peer_approval_enabled":                  optionalBool(peerApprovalEnabledDescription),
+			"network_traffic_logs_enabled":           optionalBool("Enables or disables network traffic logging"),
+			"network_traffic_packet_counter_enabled": optionalBool("Enables or disables the network traffic packet counter"),
+		},
+	}
+}
+
+func (r *AccountSettingsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(ClientInterface)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// getAccount fetches the account the provider credentials belong to.
+func (r *AccountSettingsResource) getAccount(ctx context.Context) (*netbirdApi.Account, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	accounts, err := getJSON[[]netbirdApi.Account](ctx, r.client, "/api/accounts")
+	if err != nil {
+		diags.AddError("Error fetching account", err.Error())
+		return nil, diags
+	}
+	if accounts == nil || len(*accounts) == 0 {
+		diags.AddError("Account not found", "The API did not return any account for the configured credentials")
+		return nil, diags
+	}
+	return &(*accounts)[0], diags
+}
+
+// accountSettingsModelToApi applies the known attributes of data on top of the current settings.
+func accountSettingsModelToApi(data *AccountSettingsResourceModel, settings netbirdApi.AccountSettings) (netbirdApi.AccountSettings, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if !data.PeerLoginExpirationEnabled.IsUnknown() && !data.PeerLoginExpirationEnabled.IsNull() {
+		settings.PeerLoginExpirationEnabled = data.PeerLoginExpirationEnabled.ValueBool()
+	}
+	if !data.PeerLoginExpiration.IsUnknown() && !data.PeerLoginExpiration.IsNull() {
+		settings.PeerLoginExpiration = int(data.PeerLoginExpiration.ValueInt64())
+	}
+	if !data.PeerInactivityExpirationEnabled.IsUnknown() && !data.PeerInactivityExpirationEnabled.IsNull() {
+		settings.PeerInactivityExpirationEnabled = data.PeerInactivityExpirationEnabled.ValueBool()
+	}
+	if !data.PeerInactivityExpiration.IsUnknown() && !data.PeerInactivityExpiration.IsNull() {
+		settings.PeerInactivityExpiration = int(data.PeerInactivityExpiration.ValueInt64())
+	}
+	if !data.RegularUsersViewBlocked.IsUnknown() && !data.RegularUsersViewBlocked.IsNull() {
+		settings.RegularUsersViewBlocked = data.RegularUsersViewBlocked.ValueBool()
+	}
+	if !data.GroupsPropagationEnabled.IsUnknown() && !data.GroupsPropagationEnabled.IsNull() {
+		settings.GroupsPropagationEnabled = data.GroupsPropagationEnabled.ValueBoolPointer()
+	}
+	if !data.JwtGroupsEnabled.IsUnknown() && !data.JwtGroupsEnabled.IsNull() {
+		settings.JwtGroupsEnabled = data.JwtGroupsEnabled.ValueBoolPointer()
+	}
+	if !data.JwtGroupsClaimName.IsUnknown() && !data.JwtGroupsClaimName.IsNull() {
+		settings.JwtGroupsClaimName = data.JwtGroupsClaimName.ValueStringPointer()
+	}
+	if !data.JwtAllowGroups.IsUnknown() && !data.JwtAllowGroups.IsNull() {
+		jwtAllowGroups, newDiags := convertListToStringSlice(data.JwtAllowGroups)
+		diags.Append(newDiags...)
+		settings.JwtAllowGroups = &jwtAllowGroups
+	}
+	if !data.DnsDomain.IsUnknown() && !data.DnsDomain.IsNull() {
+		settings.DnsDomain = data.DnsDomain.ValueStringPointer()
+	}
+	if !data.RoutingPeerDnsResolutionEnabled.IsUnknown() && !data.RoutingPeerDnsResolutionEnabled.IsNull() {
+		settings.RoutingPeerDnsResolutionEnabled = data.RoutingPeerDnsResolutionEnabled.ValueBoolPointer()
+	}
+
+	extra := netbirdApi.AccountExtraSettings{}
+	if settings.Extra != nil {
+		extra = *settings.Extra
+	}
+	if !data.PeerApprovalEnabled.IsUnknown() && !data.PeerApprovalEnabled.IsNull() {
+		extra.PeerApprovalEnabled = data.PeerApprovalEnabled.ValueBool()
+	}
+	if !data.NetworkTrafficLogsEnabled.IsUnknown() && !data.NetworkTrafficLogsEnabled.IsNull() {
+		extra.NetworkTrafficLogsEnabled = data.NetworkTrafficLogsEnabled.ValueBool()
+	}
+	if !data.NetworkTrafficPacketCounterEnabled.IsUnknown() && !data.NetworkTrafficPacketCounterEnabled.IsNull() {
+		extra.NetworkTrafficPacketCounterEnabled = data.NetworkTrafficPacketCounterEnabled.ValueBool()
+	}
+	settings.Extra = &extra
+
+	return settings, diags
+}
+
+func accountSettingsApiToModel(ctx context.Context, account *netbirdApi.Account, data *AccountSettingsResourceModel) diag.Diagnostics {
+	settings := account.Settings
+
+	data.ID = types.StringValue(accountSettingsID)
+	data.AccountID = types.StringValue(account.Id)
+	data.PeerLoginExpirationEnabled = types.BoolValue(settings.PeerLoginExpirationEnabled)
+	data.PeerLoginExpiration = types.Int64Value(int64(settings.PeerLoginExpiration))
+	data.PeerInactivityExpirationEnabled = types.BoolValue(settings.PeerInactivityExpirationEnabled)
+	data.PeerInactivityExpiration = types.Int64Value(int64(settings.PeerInactivityExpiration))
+	data.RegularUsersViewBlocked = types.BoolValue(settings.RegularUsersViewBlocked)
+	data.GroupsPropagationEnabled = types.BoolValue(settings.GroupsPropagationEnabled != nil && *settings.GroupsPropagationEnabled)
+	data.JwtGroupsEnabled = types.BoolValue(settings.JwtGroupsEnabled != nil && *settings.JwtGroupsEnabled)
+	data.JwtGroupsClaimName = types.StringValue(derefString(settings.JwtGroupsClaimName).ValueString())
+	data.DnsDomain = types.StringValue(derefString(settings.DnsDomain).ValueString())
+	data.RoutingPeerDnsResolutionEnabled = types.BoolValue(settings.RoutingPeerDnsResolutionEnabled != nil && *settings.RoutingPeerDnsResolutionEnabled)
+
+	extra := netbirdApi.AccountExtraSettings{}
+	if settings.Extra != nil {
+		extra = *settings.Extra
+	}
+	data.PeerApprovalEnabled = types.BoolValue(extra.PeerApprovalEnabled)
+	data.NetworkTrafficLogsEnabled = types.BoolValue(extra.NetworkTrafficLogsEnabled)
+	data.NetworkTrafficPacketCounterEnabled = types.BoolValue(extra.NetworkTrafficPacketCounterEnabled)
+
+	jwtAllowGroups := derefStringSlice(settings.JwtAllowGroups)
+	if jwtAllowGroups == nil {
+		jwtAllowGroups = []string{}
+	}
+	var diags diag.Diagnostics
+	data.JwtAllowGroups, diags = types.ListValueFrom(ctx, types.StringType, jwtAllowGroups)
+	return diags
+}
+
+// updateAccountSettings merges data into the current account settings and writes them back.
+func (r *AccountSettingsResource) updateAccountSettings(ctx context.Context, data *AccountSettingsResourceModel) diag.Diagnostics {
+	account, diags := r.getAccount(ctx)
+	if diags.HasError() {
+		return diags
+	}
+
+	settings, diags := accountSettingsModelToApi(data, account.Settings)
+	if diags.HasError() {
+		return diags
+	}
+
+	responseData, err := putJSON[netbirdApi.Account](ctx, r.client, fmt.Sprintf("/api/accounts/%s", account.Id), netbirdApi.AccountRequest{Settings: settings})
+	if err != nil {
+		diags.AddError("Error making API request", err.Error())
+		return diags
+	}
+	if responseData == nil {
+		responseData, diags = r.getAccount(ctx)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	return accountSettingsApiToModel(ctx, responseData, data)
+}
+
+func (r *AccountSettingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data AccountSettingsResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.updateAccountSettings(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AccountSettingsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data AccountSettingsResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	account, diags := r.getAccount(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(accountSettingsApiToModel(ctx, account, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AccountSettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data AccountSettingsResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.updateAccountSettings(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AccountSettingsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Account settings can not be deleted, they are left as they are.
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *AccountSettingsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// There is a single account settings object, so any import ID refers to it.
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), accountSettingsID)...)
+}
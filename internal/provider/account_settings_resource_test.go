@@ -0,0 +1,130 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccAccountSettingsResource(t *testing.T) {
+	providerConfig, _ := testAccProviderConfig(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + testAccAccountSettingsResourceConfig(43200),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("netbird_account_settings.test", "id", "account-settings"),
+					resource.TestCheckResourceAttrSet("netbird_account_settings.test", "account_id"),
+					resource.TestCheckResourceAttr("netbird_account_settings.test", "peer_login_expiration_enabled", "true"),
+					resource.TestCheckResourceAttr("netbird_account_settings.test", "peer_login_expiration", "43200"),
+					resource.TestCheckResourceAttr("netbird_account_settings.test", "jwt_allow_groups.#", "0"),
+				),
+			},
+			// ImportState testing with an arbitrary import ID
+			{
+				ResourceName:      "netbird_account_settings.test",
+				ImportState:       true,
+				ImportStateId:     "anything",
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + testAccAccountSettingsResourceConfig(86400),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("netbird_account_settings.test", "peer_login_expiration", "86400"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccAccountSettingsResourceConfig(peerLoginExpiration int) string {
+	return fmt.Sprintf(`
+resource "netbird_account_settings" "test" {
+  peer_login_expiration_enabled = true
+  peer_login_expiration         = %d
+}
+`, peerLoginExpiration)
+}
+
+func TestAccAccountSettingsResource_peerApproval(t *testing.T) {
+	// Requiring peer approval on a live account would lock out new peers
+	testAccMockOnly(t)
+	providerConfig, _ := testAccProviderConfig(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + testAccAccountSettingsResourcePeerApprovalConfig(true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("netbird_account_settings.test", "peer_approval_enabled", "true"),
+				),
+			},
+			{
+				Config: providerConfig + testAccAccountSettingsResourcePeerApprovalConfig(false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("netbird_account_settings.test", "peer_approval_enabled", "false"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAccountSettingsResourcePeerApprovalConfig(enabled bool) string {
+	return fmt.Sprintf(`
+resource "netbird_account_settings" "test" {
+  peer_approval_enabled = %t
+}
+`, enabled)
+}
+
+func TestAccAccountSettingsResource_jwtGroups(t *testing.T) {
+	// Changing JWT group sync on a live account would change the groups of its users
+	testAccMockOnly(t)
+	providerConfig, _ := testAccProviderConfig(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + testAccAccountSettingsResourceJwtGroupsConfig("groups"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("netbird_account_settings.test", "jwt_groups_enabled", "true"),
+					resource.TestCheckResourceAttr("netbird_account_settings.test", "jwt_groups_claim_name", "groups"),
+					resource.TestCheckResourceAttr("netbird_account_settings.test", "jwt_allow_groups.#", "1"),
+					resource.TestCheckResourceAttr("netbird_account_settings.test", "jwt_allow_groups.0", "netbird-users"),
+					resource.TestCheckResourceAttr("netbird_account_settings.test", "groups_propagation_enabled", "true"),
+					// Settings that are not configured keep their current value
+					resource.TestCheckResourceAttr("netbird_account_settings.test", "peer_login_expiration", "86400"),
+				),
+			},
+			{
+				Config: providerConfig + testAccAccountSettingsResourceJwtGroupsConfig("roles"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("netbird_account_settings.test", "jwt_groups_claim_name", "roles"),
+					resource.TestCheckResourceAttr("netbird_account_settings.test", "jwt_groups_enabled", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAccountSettingsResourceJwtGroupsConfig(claimName string) string {
+	return fmt.Sprintf(`
+resource "netbird_account_settings" "test" {
+  jwt_groups_enabled         = true
+  jwt_groups_claim_name      = %q
+  jwt_allow_groups           = ["netbird-users"]
+  groups_propagation_enabled = true
+}
+`, claimName)
+}
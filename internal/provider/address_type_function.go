@@ -0,0 +1,73 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &AddressTypeFunction{}
+
+func NewAddressTypeFunction() function.Function {
+	return &AddressTypeFunction{}
+}
+
+// AddressTypeFunction classifies a network resource address the same way as the NetBird server.
+type AddressTypeFunction struct{}
+
+func (f *AddressTypeFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "address_type"
+}
+
+func (f *AddressTypeFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Classify a network resource address",
+		MarkdownDescription: "Returns `host` for an IP address or single address CIDR such as `10.0.0.1/32`, `subnet` for a CIDR such as `10.0.0.0/24` " +
+			"and `domain` for a domain such as `example.com` or `*.example.com`, matching the `type` the NetBird server assigns to a `netbird_network_resource`. " +
+			"Useful for the `type` of the `source_resource` and `destination_resource` of policy rules.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "address",
+				MarkdownDescription: "Address of a network resource",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *AddressTypeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var address string
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &address))
+	if resp.Error != nil {
+		return
+	}
+
+	addressType, err := classifyAddress(address)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, addressType))
+}
+
+// classifyAddress returns the network resource type of address: host, subnet or domain.
+func classifyAddress(address string) (string, error) {
+	if prefix, err := netip.ParsePrefix(address); err == nil {
+		if prefix.Bits() == prefix.Addr().BitLen() {
+			return "host", nil
+		}
+		return "subnet", nil
+	}
+	if _, err := netip.ParseAddr(address); err == nil {
+		return "host", nil
+	}
+	if validDomain(address) {
+		return "domain", nil
+	}
+	return "", fmt.Errorf("%q is not a valid IP address, CIDR or domain", address)
+}
@@ -0,0 +1,82 @@
+package provider
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestClassifyAddress(t *testing.T) {
+	for address, want := range map[string]string{
+		"10.0.0.1":         "host",
+		"10.0.0.1/32":      "host",
+		"2001:db8::1":      "host",
+		"2001:db8::1/128":  "host",
+		"10.0.0.0/24":      "subnet",
+		"0.0.0.0/0":        "subnet",
+		"2001:db8::/32":    "subnet",
+		"example.com":      "domain",
+		"*.example.com":    "domain",
+		"internal.example": "domain",
+	} {
+		got, err := classifyAddress(address)
+		if err != nil {
+			t.Errorf("%q: unexpected error: %s", address, err)
+		} else if got != want {
+			t.Errorf("%q: expected %s, got %s", address, want, got)
+		}
+	}
+
+	for _, address := range []string{"", "10.0.0.0/33", "not a domain", "*.", "http://example.com"} {
+		if _, err := classifyAddress(address); err == nil {
+			t.Errorf("%q: expected an error", address)
+		}
+	}
+}
+
+func TestAddressTypeFunctionRun(t *testing.T) {
+	req := function.RunRequest{Arguments: function.NewArgumentsData([]attr.Value{types.StringValue("10.0.0.0/16")})}
+	resp := &function.RunResponse{Result: function.NewResultData(types.StringUnknown())}
+
+	NewAddressTypeFunction().Run(context.Background(), req, resp)
+
+	if resp.Error != nil {
+		t.Fatalf("unexpected error: %s", resp.Error)
+	}
+	if got := resp.Result.Value(); !got.Equal(types.StringValue("subnet")) {
+		t.Errorf("expected subnet, got %s", got)
+	}
+}
+
+func TestAccAddressTypeFunction(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+output "type" {
+  value = provider::netbird::address_type("192.168.1.0/24")
+}
+`,
+				Check: resource.TestCheckOutput("type", "subnet"),
+			},
+			{
+				Config: `
+output "type" {
+  value = provider::netbird::address_type("not a domain")
+}
+`,
+				ExpectError: regexp.MustCompile(`not a valid IP\s+address,\s+CIDR\s+or\s+domain`),
+			},
+		},
+	})
+}
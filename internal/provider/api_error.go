@@ -0,0 +1,92 @@
+package provider
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// maxErrorBodyLength limits how much of a non-JSON error body is shown to users.
+const maxErrorBodyLength = 512
+
+// APIError is returned by the client when the NetBird API responds with an error status.
+type APIError struct {
+	Method     string
+	Path       string
+	StatusCode int
+	// Message and Code are parsed from the NetBird error payload, if present.
+	Message string
+	Code    int
+	// Body is the raw response body, truncated, used when no message could be parsed.
+	Body string
+}
+
+func newAPIError(req *http.Request, statusCode int, body []byte) *APIError {
+	apiErr := &APIError{
+		Method:     req.Method,
+		Path:       req.URL.Path,
+		StatusCode: statusCode,
+	}
+
+	var payload struct {
+		Message string `json:"message"`
+		Code    int    `json:"code"`
+	}
+	if err := json.Unmarshal(body, &payload); err == nil && payload.Message != "" {
+		apiErr.Message = payload.Message
+		apiErr.Code = payload.Code
+		return apiErr
+	}
+
+	apiErr.Body = strings.TrimSpace(string(body))
+	if len(apiErr.Body) > maxErrorBodyLength {
+		apiErr.Body = apiErr.Body[:maxErrorBodyLength] + "... (truncated)"
+	}
+	return apiErr
+}
+
+func (e *APIError) Error() string {
+	message := e.Message
+	if message == "" {
+		message = e.Body
+	}
+	if message == "" {
+		message = "empty response body"
+	}
+
+	text := fmt.Sprintf("%s %s returned %d %s: %s", e.Method, e.Path, e.StatusCode, http.StatusText(e.StatusCode), message)
+	if hint := e.hint(); hint != "" {
+		text += "\n\n" + hint
+	}
+	return text
+}
+
+// hint suggests a likely cause for common error statuses.
+func (e *APIError) hint() string {
+	switch e.StatusCode {
+	case http.StatusUnauthorized:
+		return "Check that the provider access_token or bearer_token is valid and has not expired."
+	case http.StatusForbidden:
+		return "The user owning the provider credentials is not permitted to perform this operation."
+	case http.StatusTooManyRequests:
+		return "The NetBird API rate limit was exceeded, consider reducing Terraform parallelism."
+	}
+	return ""
+}
+
+// ErrNotFound matches API errors for objects that do not exist using errors.Is.
+// Reads of missing objects return no body rather than an error, only other
+// requests such as deletes report it.
+var ErrNotFound = errors.New("object not found")
+
+// Is reports whether e is an ErrNotFound.
+func (e *APIError) Is(target error) bool {
+	return target == ErrNotFound && e.StatusCode == http.StatusNotFound
+}
+
+// isNotFound reports whether err is an API error for an object that does not exist.
+func isNotFound(err error) bool {
+	return errors.Is(err, ErrNotFound)
+}
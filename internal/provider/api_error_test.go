@@ -0,0 +1,126 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	netbirdApi "github.com/netbirdio/netbird/management/server/http/api"
+)
+
+func TestAPIErrorParsesNetBirdPayload(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		_, _ = w.Write([]byte(`{"message":"invalid character","code":422}`))
+	}))
+	defer server.Close()
+
+	_, err := newTestClient(server).DoPut(context.Background(), "/api/groups/g1", []byte(`{}`))
+
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("expected *APIError, got %T: %v", err, err)
+	}
+	if apiErr.StatusCode != 422 || apiErr.Code != 422 || apiErr.Message != "invalid character" {
+		t.Errorf("unexpected error fields: %+v", apiErr)
+	}
+	if apiErr.Method != "PUT" || apiErr.Path != "/api/groups/g1" {
+		t.Errorf("unexpected request fields: %+v", apiErr)
+	}
+	if want := "PUT /api/groups/g1 returned 422 Unprocessable Entity: invalid character"; err.Error() != want {
+		t.Errorf("expected %q, got %q", want, err.Error())
+	}
+}
+
+func TestAPIErrorFallsBackToTruncatedBody(t *testing.T) {
+	body := strings.Repeat("x", maxErrorBodyLength+100)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(body))
+	}))
+	defer server.Close()
+
+	_, err := newTestClient(server).DoGet(context.Background(), "/api/groups")
+
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("expected *APIError, got %T: %v", err, err)
+	}
+	if apiErr.Message != "" {
+		t.Errorf("expected no parsed message, got %q", apiErr.Message)
+	}
+	if !strings.HasSuffix(apiErr.Body, "... (truncated)") || len(apiErr.Body) != maxErrorBodyLength+len("... (truncated)") {
+		t.Errorf("expected truncated body, got %d bytes", len(apiErr.Body))
+	}
+}
+
+func TestAPIErrorHint(t *testing.T) {
+	err := &APIError{Method: "GET", Path: "/api/groups", StatusCode: 401, Message: "token invalid", Code: 401}
+	if !strings.Contains(err.Error(), "access_token or bearer_token") {
+		t.Errorf("expected an authentication hint, got %q", err.Error())
+	}
+}
+
+func TestAPIErrorIsNotFound(t *testing.T) {
+	notFound := fmt.Errorf("wrapped: %w", &APIError{Method: "PUT", Path: "/api/groups/g1", StatusCode: http.StatusNotFound})
+	if !errors.Is(notFound, ErrNotFound) || !isNotFound(notFound) {
+		t.Errorf("expected %v to be ErrNotFound", notFound)
+	}
+
+	forbidden := &APIError{Method: "PUT", Path: "/api/groups/g1", StatusCode: http.StatusForbidden}
+	if errors.Is(forbidden, ErrNotFound) || isNotFound(forbidden) {
+		t.Errorf("expected %v not to be ErrNotFound", forbidden)
+	}
+}
+
+func TestRequestErrorsNameTheRequest(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/policies/abc123":
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			_, _ = w.Write([]byte(`{"message":"rule ports invalid","code":422}`))
+		case "/api/groups/bad":
+			_, _ = w.Write([]byte(`{"id":`))
+		case "/api/groups":
+			w.WriteHeader(http.StatusNotFound)
+		default:
+			w.WriteHeader(http.StatusNoContent)
+		}
+	}))
+	defer server.Close()
+	client := newTestClient(server)
+	ctx := context.Background()
+
+	readOnly := newTestClient(server)
+	readOnly.ReadOnly = true
+
+	_, putErr := putJSON[netbirdApi.Policy](ctx, client, "/api/policies/abc123", netbirdApi.PolicyUpdate{})
+	_, parseErr := getJSON[netbirdApi.Group](ctx, client, "/api/groups/bad")
+	_, missingErr := postJSON[netbirdApi.Group](ctx, client, "/api/groups", netbirdApi.GroupRequest{})
+	_, noContentErr := postJSON[netbirdApi.Group](ctx, client, "/api/networks", map[string]string{})
+	deleteErr := deleteObject(ctx, readOnly, "/api/groups/g1")
+
+	for _, tc := range []struct {
+		err  error
+		want string
+	}{
+		{putErr, "PUT /api/policies/abc123 returned 422 Unprocessable Entity: rule ports invalid"},
+		{parseErr, "GET /api/groups/bad: error parsing response: unexpected end of JSON input"},
+		{missingErr, "POST /api/groups: the API returned no content, the object may have been deleted outside of Terraform"},
+		{noContentErr, "POST /api/networks: the API returned no content"},
+		{deleteErr, "DELETE /api/groups/g1: provider is configured in read-only mode"},
+	} {
+		if tc.err == nil || tc.err.Error() != tc.want {
+			t.Errorf("expected %q, got %v", tc.want, tc.err)
+		}
+	}
+
+	// Wrapped errors can still be told apart
+	if !errors.Is(noContentErr, errNoContent) || !errors.Is(deleteErr, errReadOnly) {
+		t.Errorf("expected wrapped errors to match their sentinels, got %v and %v", noContentErr, deleteErr)
+	}
+}
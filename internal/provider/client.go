@@ -1,54 +1,1143 @@
 package provider
 
 import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
+	"math/rand"
+	"net"
 	"net/http"
+	"net/url"
+	"os"
+	"runtime"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
 	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	netbirdApi "github.com/netbirdio/netbird/management/server/http/api"
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/clientcredentials"
+	"golang.org/x/time/rate"
 )
 
+const (
+	defaultRequestTimeout = 60 * time.Second
+
+	// Terraform runs up to 10 operations in parallel by default, keep enough
+	// idle connections to the API to avoid a new TLS handshake per request.
+	defaultMaxIdleConnsPerHost = 32
+	defaultIdleConnTimeout     = 90 * time.Second
+	defaultKeepAlive           = 30 * time.Second
+
+	defaultRetryAttempts = 4
+	defaultRetryWaitMin  = 1 * time.Second
+	defaultRetryWaitMax  = 30 * time.Second
+
+	// Requests taking longer are logged as warnings, to find the endpoints
+	// slowing down a plan or apply.
+	defaultSlowRequestThreshold = 5 * time.Second
+
+	// Lists such as groups are read by many resources and data sources during
+	// one plan or apply, reuse them for a short while rather than fetching them
+	// every time.
+	defaultResponseCacheTTL = 5 * time.Second
+)
+
+// errReadOnly is returned for write requests when the provider is in read-only mode.
+var errReadOnly = errors.New("provider is configured in read-only mode")
+
+// ClientInterface describes the API operations used by resources and data sources.
+// Paths are relative to the API endpoint, e.g. `/api/groups`. Responses for
+// objects that do not exist have a nil body, successful responses without
+// content an empty one.
+type ClientInterface interface {
+	DoGet(ctx context.Context, path string) ([]byte, error)
+	DoPost(ctx context.Context, path string, body []byte) ([]byte, error)
+	DoPut(ctx context.Context, path string, body []byte) ([]byte, error)
+	DoDelete(ctx context.Context, path string) error
+}
+
+// Ensure Client satisfies ClientInterface and supports paging of list
+// endpoints and group lookups.
+var _ ClientInterface = &Client{}
+var _ pageFetcher = &Client{}
+var _ groupLister = &Client{}
+
+// Client is safe for concurrent use by multiple resources and data sources.
 type Client struct {
 	BaseUrl     string
 	BearerToken string
 	AccessToken string
-	httpClient  *http.Client
+	UserAgent   string
+	// ReadOnly rejects every request other than GET.
+	ReadOnly   bool
+	httpClient *http.Client
+
+	// tokenSource obtains bearer tokens using OAuth2 client credentials, nil
+	// when authenticating with BearerToken or AccessToken.
+	tokenSource oauth2.TokenSource
+
+	// bearerTokenRefresh returns the current bearer token, e.g. re-read from a
+	// file, when a request is rejected as unauthorized. nil when the token cannot
+	// change. bearerTokenMu guards BearerToken once requests have started.
+	bearerTokenRefresh func() (string, error)
+	bearerTokenMu      sync.RWMutex
+
+	// limiter paces requests across all resources and data sources, nil when unlimited.
+	limiter *rate.Limiter
+
+	// retryAttempts is the maximum number of attempts for requests failing
+	// with 429 or 5xx responses, backing off between retryWaitMin and retryWaitMax.
+	// See retryRequest for which requests are retried.
+	retryAttempts int
+	retryWaitMin  time.Duration
+	retryWaitMax  time.Duration
+
+	// slowRequestThreshold is the duration after which a request is logged
+	// as slow, disabled when zero.
+	slowRequestThreshold time.Duration
+
+	// operationStats counts the requests of each operation in progress by the
+	// Done channel of its context, see recordRequest.
+	operationStatsMu sync.Mutex
+	operationStats   map[<-chan struct{}]map[string]*endpointStats
+
+	// responseCache holds GET responses of list endpoints by URL for
+	// responseCacheTTL, disabled when zero. It is cleared by any write.
+	responseCacheTTL time.Duration
+	responseCacheMu  sync.Mutex
+	responseCache    map[string]*cachedResponse
 }
 
-func NewClient(baseURL string, bearerToken string, accessToken string) *Client {
+// endpointStats counts the requests to an endpoint during an operation.
+type endpointStats struct {
+	requests int
+	duration time.Duration
+}
+
+// cachedResponse is a response held by the client response cache.
+type cachedResponse struct {
+	// mu is held while fetching so parallel reads of a list share a single request
+	mu        sync.Mutex
+	body      []byte
+	header    http.Header
+	fetchedAt time.Time
+}
+
+func NewClient(baseURL string, bearerToken string, accessToken string, version string) *Client {
 	return &Client{
 		BaseUrl:     baseURL,
 		BearerToken: bearerToken,
 		AccessToken: accessToken,
+		UserAgent:   fmt.Sprintf("terraform-provider-netbird/%s (%s; terraform-plugin-framework)", version, runtime.GOOS),
 		httpClient: &http.Client{
-			Timeout: 60 * time.Second,
+			Timeout:   defaultRequestTimeout,
+			Transport: newTransport(),
 		},
+		retryAttempts:        defaultRetryAttempts,
+		retryWaitMin:         defaultRetryWaitMin,
+		retryWaitMax:         defaultRetryWaitMax,
+		responseCacheTTL:     defaultResponseCacheTTL,
+		slowRequestThreshold: defaultSlowRequestThreshold,
 	}
 }
 
-func (s *Client) doRequest(req *http.Request) ([]byte, error) {
-	if s.BearerToken != "" {
-		req.Header.Set("Authorization", "Bearer "+s.BearerToken)
+// newTransport returns the HTTP transport shared by all requests, reusing
+// connections to the API and negotiating HTTP/2 where the server supports it.
+func newTransport() *http.Transport {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.DialContext = (&net.Dialer{
+		Timeout:   30 * time.Second,
+		KeepAlive: defaultKeepAlive,
+	}).DialContext
+	transport.MaxIdleConns = 2 * defaultMaxIdleConnsPerHost
+	transport.MaxIdleConnsPerHost = defaultMaxIdleConnsPerHost
+	transport.IdleConnTimeout = defaultIdleConnTimeout
+	// Keep HTTP/2 enabled when a custom TLS config is set by ConfigureTLS
+	transport.ForceAttemptHTTP2 = true
+	return transport
+}
+
+// normalizeEndpoint validates the NetBird API endpoint and strips trailing
+// slashes, keeping any path prefix the API is served under behind a reverse proxy.
+func normalizeEndpoint(endpoint string) (string, error) {
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return "", fmt.Errorf("invalid endpoint URL: %w", err)
 	}
-	if s.AccessToken != "" {
-		req.Header.Set("Authorization", "Token "+s.AccessToken)
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return "", fmt.Errorf("unsupported endpoint scheme %q, expected http or https", u.Scheme)
+	}
+	if u.Host == "" {
+		return "", errors.New("endpoint URL must include a host")
+	}
+	if u.RawQuery != "" || u.Fragment != "" {
+		return "", errors.New("endpoint URL must not include a query or fragment")
+	}
+	if u.User != nil {
+		return "", errors.New("endpoint URL must not include credentials, use access_token or bearer_token instead")
+	}
+	u.Path = strings.TrimRight(u.Path, "/")
+	u.RawPath = ""
+	return u.String(), nil
+}
+
+// SetRateLimit limits the client to requestsPerSecond requests, spaced evenly.
+func (s *Client) SetRateLimit(requestsPerSecond float64) {
+	s.limiter = rate.NewLimiter(rate.Limit(requestsPerSecond), 1)
+}
+
+// SetRetryPolicy retries failed requests up to maxRetries times, waiting at
+// most maxBackoff between attempts. Retries are disabled when maxRetries is zero.
+func (s *Client) SetRetryPolicy(maxRetries int, maxBackoff time.Duration) {
+	s.retryAttempts = maxRetries + 1
+	s.retryWaitMax = maxBackoff
+}
+
+// postRetryKey is the context key of the postRetry of a POST request.
+type postRetryKey struct{}
+
+// postRetry opts a POST request into being retried after server errors.
+type postRetry struct {
+	// prepareLookup returns the lookup of the object created by a failed attempt.
+	prepareLookup func(ctx context.Context) (createdLookup, error)
+}
+
+// createdLookup returns the object created by a failed attempt, nil if none was created.
+type createdLookup func(ctx context.Context) ([]byte, error)
+
+// withPostRetry opts POST requests made with ctx into being retried after
+// server errors like other methods. By default POST requests are only retried
+// when rate limited, which the API rejects before processing them, as a
+// request failing after the object was created, e.g. when the response was
+// lost, would create the object twice.
+//
+// prepareLookup, unless nil, is called before the request is first sent, and
+// only when retries are enabled, e.g. to record the objects which already
+// exist. Before retrying, the lookup it returns is called to find an object
+// created by the failed attempt, which is then returned as the response instead.
+func withPostRetry(ctx context.Context, prepareLookup func(ctx context.Context) (createdLookup, error)) context.Context {
+	return context.WithValue(ctx, postRetryKey{}, postRetry{prepareLookup: prepareLookup})
+}
+
+// namedObject holds the fields of API objects used to identify them by name.
+type namedObject struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// lookupCreatedByName prepares a lookup for withPostRetry, finding the object
+// named name that a request creating it at listPath may have created. Objects
+// with that name which already exist when the lookup is prepared are ignored,
+// so they are not mistaken for the new one.
+func lookupCreatedByName(client ClientInterface, listPath string, name string) func(ctx context.Context) (createdLookup, error) {
+	findNamed := func(ctx context.Context) (map[string][]byte, error) {
+		objects, err := getJSON[[]json.RawMessage](ctx, client, listPath)
+		if err != nil || objects == nil {
+			return nil, err
+		}
+		named := map[string][]byte{}
+		for _, raw := range *objects {
+			var object namedObject
+			if err := json.Unmarshal(raw, &object); err != nil {
+				return nil, requestError(http.MethodGet, listPath, fmt.Errorf("error parsing response: %w", err))
+			}
+			if object.Name == name {
+				named[object.ID] = raw
+			}
+		}
+		return named, nil
 	}
 
-	resp, err := s.httpClient.Do(req)
+	return func(ctx context.Context) (createdLookup, error) {
+		existing, err := findNamed(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return func(ctx context.Context) ([]byte, error) {
+			named, err := findNamed(ctx)
+			if err != nil {
+				return nil, err
+			}
+			for id, raw := range named {
+				if _, ok := existing[id]; !ok {
+					return raw, nil
+				}
+			}
+			return nil, nil
+		}, nil
+	}
+}
+
+// ConfigureOAuth authenticates requests with bearer tokens obtained from
+// tokenURL using the OAuth2 client credentials grant. Tokens are cached and
+// refreshed once they expire.
+func (s *Client) ConfigureOAuth(clientID string, clientSecret string, tokenURL string, audience string, scopes []string) {
+	config := clientcredentials.Config{
+		ClientID:     clientID,
+		ClientSecret: clientSecret,
+		TokenURL:     tokenURL,
+		Scopes:       scopes,
+	}
+	if audience != "" {
+		config.EndpointParams = url.Values{"audience": {audience}}
+	}
+	// Fetch tokens with the same TLS, proxy and timeout settings as API requests
+	ctx := context.WithValue(context.Background(), oauth2.HTTPClient, s.httpClient)
+	s.tokenSource = config.TokenSource(ctx)
+}
+
+// SetBearerTokenRefresh sets a callback returning the current bearer token.
+// Requests rejected with 401 Unauthorized are retried once with the refreshed
+// token, unless it is the same token that was rejected.
+func (s *Client) SetBearerTokenRefresh(refresh func() (string, error)) {
+	s.bearerTokenRefresh = refresh
+}
+
+func (s *Client) bearerToken() string {
+	s.bearerTokenMu.RLock()
+	defer s.bearerTokenMu.RUnlock()
+	return s.BearerToken
+}
+
+// refreshBearerToken replaces the bearer token of req, which was rejected as
+// unauthorized, reporting whether a different token is available to retry with.
+func (s *Client) refreshBearerToken(req *http.Request) bool {
+	if s.bearerTokenRefresh == nil {
+		return false
+	}
+
+	token, err := s.bearerTokenRefresh()
 	if err != nil {
+		tflog.Warn(req.Context(), "Failed to refresh bearer token", map[string]interface{}{
+			"error": err.Error(),
+		})
+		return false
+	}
+	if token == "" || "Bearer "+token == req.Header.Get("Authorization") {
+		return false
+	}
+
+	s.bearerTokenMu.Lock()
+	s.BearerToken = token
+	s.bearerTokenMu.Unlock()
+	tflog.Debug(req.Context(), "Retrying API request with refreshed bearer token", map[string]interface{}{
+		"method": req.Method,
+		"url":    req.URL.String(),
+	})
+	req.Header.Set("Authorization", "Bearer "+token)
+	return true
+}
+
+// ConfigureTLS trusts the given CA certificate, either PEM encoded or a path to
+// a PEM file, in addition to the system roots. insecureSkipVerify disables
+// certificate verification altogether.
+func (s *Client) ConfigureTLS(caCertificate string, insecureSkipVerify bool) error {
+	transport, ok := s.httpClient.Transport.(*http.Transport)
+	if !ok {
+		return fmt.Errorf("unexpected transport type %T", s.httpClient.Transport)
+	}
+	tlsConfig := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		InsecureSkipVerify: insecureSkipVerify,
+	}
+
+	if caCertificate != "" {
+		pem, err := readPEM(caCertificate)
+		if err != nil {
+			return fmt.Errorf("error reading CA certificate: %w", err)
+		}
+
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM(pem) {
+			return errors.New("no PEM encoded certificates found in CA certificate")
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	transport.TLSClientConfig = tlsConfig
+	return nil
+}
+
+// ConfigureClientCertificate presents a client certificate for mutual TLS.
+// Both certificate and key are PEM encoded content or paths to PEM files. It
+// must be called after ConfigureTLS, which replaces the TLS configuration.
+func (s *Client) ConfigureClientCertificate(certificate string, key string) error {
+	transport, ok := s.httpClient.Transport.(*http.Transport)
+	if !ok {
+		return fmt.Errorf("unexpected transport type %T", s.httpClient.Transport)
+	}
+
+	certPEM, err := readPEM(certificate)
+	if err != nil {
+		return fmt.Errorf("error reading client certificate: %w", err)
+	}
+	keyPEM, err := readPEM(key)
+	if err != nil {
+		return fmt.Errorf("error reading client key: %w", err)
+	}
+	pair, err := tls.X509KeyPair(certPEM, keyPEM)
+	if err != nil {
+		return fmt.Errorf("invalid client certificate and key: %w", err)
+	}
+
+	if transport.TLSClientConfig == nil {
+		transport.TLSClientConfig = &tls.Config{MinVersion: tls.VersionTLS12}
+	}
+	transport.TLSClientConfig.Certificates = []tls.Certificate{pair}
+	return nil
+}
+
+// readPEM returns value if it is PEM encoded, otherwise the content of the file it names.
+func readPEM(value string) ([]byte, error) {
+	if strings.Contains(value, "-----BEGIN") {
+		return []byte(value), nil
+	}
+	return os.ReadFile(value)
+}
+
+// ConfigureProxy sends requests through the proxy at proxyURL, which may
+// include credentials. By default the HTTPS_PROXY and NO_PROXY environment
+// variables are honored.
+func (s *Client) ConfigureProxy(proxyURL string) error {
+	transport, ok := s.httpClient.Transport.(*http.Transport)
+	if !ok {
+		return fmt.Errorf("unexpected transport type %T", s.httpClient.Transport)
+	}
+
+	parsed, err := url.Parse(proxyURL)
+	if err != nil {
+		// The error includes the URL, which may contain credentials.
+		return errors.New("proxy URL could not be parsed")
+	}
+	switch parsed.Scheme {
+	case "http", "https", "socks5":
+	default:
+		return fmt.Errorf("proxy URL %q must use the http, https or socks5 scheme", parsed.Redacted())
+	}
+	if parsed.Host == "" {
+		return fmt.Errorf("proxy URL %q has no host", parsed.Redacted())
+	}
+
+	transport.Proxy = http.ProxyURL(parsed)
+	return nil
+}
+
+func (s *Client) DoGet(ctx context.Context, path string) ([]byte, error) {
+	body, _, err := s.do(ctx, "GET", path, nil)
+	return body, err
+}
+
+func (s *Client) DoPost(ctx context.Context, path string, body []byte) ([]byte, error) {
+	body, _, err := s.do(ctx, "POST", path, body)
+	return body, err
+}
+
+func (s *Client) DoPut(ctx context.Context, path string, body []byte) ([]byte, error) {
+	body, _, err := s.do(ctx, "PUT", path, body)
+	return body, err
+}
+
+func (s *Client) DoDelete(ctx context.Context, path string) error {
+	_, _, err := s.do(ctx, "DELETE", path, nil)
+	return err
+}
+
+// GetGroups returns every group in the account, reusing a recent response
+// when the response cache is enabled.
+func (s *Client) GetGroups(ctx context.Context) ([]netbirdApi.Group, error) {
+	groups, err := getJSON[[]netbirdApi.Group](ctx, s, "/api/groups")
+	if err != nil || groups == nil {
 		return nil, err
 	}
-	defer resp.Body.Close()
-	body, err := io.ReadAll(resp.Body)
+	return *groups, nil
+}
+
+// getPage fetches one page of a list endpoint, see getAllPages.
+func (s *Client) getPage(ctx context.Context, path string) (*listPage, error) {
+	body, header, err := s.do(ctx, "GET", path, nil)
 	if err != nil {
 		return nil, err
 	}
 
-	if resp.StatusCode == 404 {
+	page := &listPage{body: body, total: -1}
+	if next := nextPageLink(header.Values("Link")); next != "" {
+		page.next, err = s.relativePath(path, next)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if total, err := strconv.Atoi(header.Get("X-Total-Count")); err == nil && total >= 0 {
+		page.total = total
+	}
+	return page, nil
+}
+
+// relativePath resolves link, as found in a Link header of the response to
+// path, to a path relative to the API endpoint.
+func (s *Client) relativePath(path string, link string) (string, error) {
+	current, err := url.Parse(s.BaseUrl + path)
+	if err != nil {
+		return "", err
+	}
+	target, err := url.Parse(link)
+	if err != nil {
+		return "", fmt.Errorf("invalid next page link %q: %w", link, err)
+	}
+
+	resolved := current.ResolveReference(target).String()
+	if !strings.HasPrefix(resolved, s.BaseUrl+"/") {
+		return "", fmt.Errorf("next page link %q is outside of the API endpoint", link)
+	}
+	return strings.TrimPrefix(resolved, s.BaseUrl), nil
+}
+
+// SetResponseCache sets how long GET responses of list endpoints are reused,
+// zero disables the cache.
+func (s *Client) SetResponseCache(ttl time.Duration) {
+	s.responseCacheMu.Lock()
+	defer s.responseCacheMu.Unlock()
+	s.responseCacheTTL = ttl
+	s.responseCache = nil
+}
+
+// cachedGet sends req, a GET request, reusing a recent response to the same URL
+// when it was a list. Cached responses are shared and must not be modified.
+func (s *Client) cachedGet(req *http.Request) ([]byte, http.Header, error) {
+	key := req.URL.String()
+	s.responseCacheMu.Lock()
+	if s.responseCache == nil {
+		s.responseCache = map[string]*cachedResponse{}
+	}
+	entry, ok := s.responseCache[key]
+	if !ok {
+		entry = &cachedResponse{}
+		s.responseCache[key] = entry
+	}
+	s.responseCacheMu.Unlock()
+
+	entry.mu.Lock()
+	defer entry.mu.Unlock()
+	if entry.body != nil && time.Since(entry.fetchedAt) < s.responseCacheTTL {
+		return entry.body, entry.header, nil
+	}
+
+	body, header, err := s.doRequest(req)
+	// Only lists are cached, single objects are read once per resource anyway
+	if err == nil && bytes.HasPrefix(bytes.TrimSpace(body), []byte("[")) {
+		entry.body, entry.header, entry.fetchedAt = body, header, time.Now()
+	}
+	return body, header, err
+}
+
+func (s *Client) invalidateResponseCache() {
+	s.responseCacheMu.Lock()
+	defer s.responseCacheMu.Unlock()
+	s.responseCache = nil
+}
+
+// deleteObject deletes the object at path, treating an object that no longer
+// exists, e.g. removed outside of Terraform or by a cascade on the server, as
+// successfully deleted.
+func deleteObject(ctx context.Context, client ClientInterface, path string) error {
+	err := client.DoDelete(ctx, path)
+	if isNotFound(err) {
+		tflog.Debug(ctx, "Object already deleted", map[string]interface{}{
+			"path": path,
+		})
+		return nil
+	}
+	return requestError(http.MethodDelete, path, err)
+}
+
+// requestError prefixes err with the method and path of the request it
+// occurred in, so diagnostics show which of many requests failed. API and
+// transport errors already name the request and are returned as is.
+func requestError(method string, path string, err error) error {
+	var apiErr *APIError
+	var urlErr *url.Error
+	if err == nil || errors.As(err, &apiErr) || errors.As(err, &urlErr) {
+		return err
+	}
+	return fmt.Errorf("%s %s: %w", method, path, err)
+}
+
+// errNoContent is returned by doJSON for successful responses without a body,
+// such as 204 No Content, which unlike missing objects have nothing to decode.
+var errNoContent = errors.New("the API returned no content")
+
+// doJSON sends body, unless nil, encoded as JSON and decodes the response into
+// a T. It returns nil without an error for objects that do not exist, and
+// errNoContent when the request succeeded without returning a body.
+func doJSON[T any](ctx context.Context, client ClientInterface, method string, path string, body any) (*T, error) {
+	var requestBody []byte
+	if body != nil {
+		var err error
+		requestBody, err = json.Marshal(body)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling request body: %w", err)
+		}
+	}
+
+	var responseBody []byte
+	var err error
+	switch method {
+	case http.MethodGet:
+		responseBody, err = client.DoGet(ctx, path)
+	case http.MethodPost:
+		responseBody, err = client.DoPost(ctx, path, requestBody)
+	case http.MethodPut:
+		responseBody, err = client.DoPut(ctx, path, requestBody)
+	default:
+		return nil, fmt.Errorf("unsupported method %s", method)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if responseBody == nil {
+		return nil, nil
+	}
+	if len(bytes.TrimSpace(responseBody)) == 0 {
+		return nil, errNoContent
+	}
+
+	var result T
+	if err := json.Unmarshal(responseBody, &result); err != nil {
+		return nil, fmt.Errorf("error parsing response: %w", err)
+	}
+	return &result, nil
+}
+
+// getJSON fetches path, returning nil if the object does not exist.
+func getJSON[T any](ctx context.Context, client ClientInterface, path string) (*T, error) {
+	result, err := doJSON[T](ctx, client, http.MethodGet, path, nil)
+	if errors.Is(err, errNoContent) {
+		return nil, nil
+	}
+	return result, requestError(http.MethodGet, path, err)
+}
+
+// postJSON creates an object at path and returns the created object.
+func postJSON[T any](ctx context.Context, client ClientInterface, path string, body any) (*T, error) {
+	result, err := requireJSON(doJSON[T](ctx, client, http.MethodPost, path, body))
+	return result, requestError(http.MethodPost, path, err)
+}
+
+// putJSON updates the object at path and returns the updated object. It
+// returns nil without an error when the API responds without content, callers
+// needing the updated object must then read it back.
+func putJSON[T any](ctx context.Context, client ClientInterface, path string, body any) (*T, error) {
+	result, err := requireJSON(doJSON[T](ctx, client, http.MethodPut, path, body))
+	if errors.Is(err, errNoContent) {
+		return nil, nil
+	}
+	return result, requestError(http.MethodPut, path, err)
+}
+
+// requireJSON fails for missing objects, which are returned when the object
+// being written no longer exists.
+func requireJSON[T any](result *T, err error) (*T, error) {
+	if err == nil && result == nil {
+		return nil, errors.New("the API returned no content, the object may have been deleted outside of Terraform")
+	}
+	return result, err
+}
+
+// maxListPages stops getAllPages following a server that never runs out of pages.
+const maxListPages = 1000
+
+// listPage is one page of a list endpoint response.
+type listPage struct {
+	body []byte
+	// next is the path of the following page from the Link header, empty if there is none.
+	next string
+	// total is the number of items across all pages from the X-Total-Count header, -1 if absent.
+	total int
+}
+
+// pageFetcher is implemented by clients exposing the paging headers of list
+// endpoints, see Client.getPage.
+type pageFetcher interface {
+	getPage(ctx context.Context, path string) (*listPage, error)
+}
+
+// getAllPages fetches every item of the list endpoint at path. Pages are
+// followed using the "next" Link header, or otherwise by incrementing the page
+// query parameter until X-Total-Count items have been read. Servers that do
+// not page their responses are read with a single request.
+func getAllPages[T any](ctx context.Context, client ClientInterface, path string) ([]T, error) {
+	fetcher, ok := client.(pageFetcher)
+	if !ok {
+		items, err := getJSON[[]T](ctx, client, path)
+		if err != nil || items == nil {
+			return nil, err
+		}
+		return *items, nil
+	}
+
+	var all []T
+	pageNumber := 1
+	for pages := 1; ; pages++ {
+		if pages > maxListPages {
+			return nil, fmt.Errorf("stopped listing %s after %d pages", path, maxListPages)
+		}
+
+		page, err := fetcher.getPage(ctx, path)
+		if err != nil {
+			return nil, requestError(http.MethodGet, path, err)
+		}
+		if len(page.body) == 0 {
+			return all, nil
+		}
+
+		var items []T
+		if err := json.Unmarshal(page.body, &items); err != nil {
+			return nil, requestError(http.MethodGet, path, fmt.Errorf("error parsing response: %w", err))
+		}
+		all = append(all, items...)
+
+		switch {
+		case page.next != "":
+			path = page.next
+		case page.total >= 0 && len(items) > 0 && len(all) < page.total:
+			pageNumber++
+			path, err = withQueryParameter(path, "page", strconv.Itoa(pageNumber))
+			if err != nil {
+				return nil, err
+			}
+		default:
+			return all, nil
+		}
+	}
+}
+
+// nextPageLink returns the target of the rel="next" entry of Link header values,
+// or an empty string if there is none.
+func nextPageLink(values []string) string {
+	for _, value := range values {
+		for _, link := range strings.Split(value, ",") {
+			parts := strings.Split(link, ";")
+			target := strings.TrimSpace(parts[0])
+			if !strings.HasPrefix(target, "<") || !strings.HasSuffix(target, ">") {
+				continue
+			}
+			for _, param := range parts[1:] {
+				key, value, _ := strings.Cut(strings.TrimSpace(param), "=")
+				if strings.EqualFold(key, "rel") && slices.Contains(strings.Fields(strings.Trim(value, `"`)), "next") {
+					return strings.TrimSuffix(strings.TrimPrefix(target, "<"), ">")
+				}
+			}
+		}
+	}
+	return ""
+}
+
+// withQueryParameter returns path with the query parameter key set to value.
+func withQueryParameter(path string, key string, value string) (string, error) {
+	parsed, err := url.Parse(path)
+	if err != nil {
+		return "", err
+	}
+	query := parsed.Query()
+	query.Set(key, value)
+	parsed.RawQuery = query.Encode()
+	return parsed.String(), nil
+}
+
+func (s *Client) do(ctx context.Context, method string, path string, body []byte) ([]byte, http.Header, error) {
+	var bodyReader io.Reader
+	if body != nil {
+		bodyReader = bytes.NewBuffer(body)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, s.BaseUrl+path, bodyReader)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error creating request: %w", err)
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	if method == http.MethodGet && s.responseCacheTTL > 0 {
+		return s.cachedGet(req)
+	}
+	if method != http.MethodGet {
+		// A write may change any list, e.g. deleting a group removes it from
+		// peers and policies. Clear the cache once the write has completed,
+		// whether or not it succeeded
+		defer s.invalidateResponseCache()
+	}
+
+	return s.doRequest(req)
+}
+
+func (s *Client) doRequest(req *http.Request) ([]byte, http.Header, error) {
+	if s.ReadOnly && req.Method != http.MethodGet {
+		return nil, nil, errReadOnly
+	}
+
+	req.Header.Set("User-Agent", s.UserAgent)
+	if bearerToken := s.bearerToken(); bearerToken != "" {
+		req.Header.Set("Authorization", "Bearer "+bearerToken)
+	}
+	if s.AccessToken != "" {
+		req.Header.Set("Authorization", "Token "+s.AccessToken)
+	}
+	if s.tokenSource != nil {
+		token, err := s.tokenSource.Token()
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to obtain OAuth2 token: %w", err)
+		}
+		token.SetAuthHeader(req)
+	}
+
+	if debugCurl, _ := strconv.ParseBool(os.Getenv("NETBIRD_DEBUG_CURL")); debugCurl {
+		tflog.Debug(req.Context(), "NetBird API request as curl command", map[string]interface{}{
+			"curl": curlCommand(req),
+		})
+	}
+
+	lookup, err := s.preparePostRetry(req)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	refreshed := false
+	for attempt := 1; ; attempt++ {
+		if s.limiter != nil {
+			if err := s.limiter.Wait(req.Context()); err != nil {
+				return nil, nil, err
+			}
+		}
+
+		start := time.Now()
+		resp, err := s.httpClient.Do(req)
+		if err != nil {
+			return nil, nil, err
+		}
+		body, err := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			return nil, nil, err
+		}
+		duration := time.Since(start)
+		logResponse(req, resp, body, attempt, duration)
+		s.recordRequest(req, resp.StatusCode, duration)
+
+		if retryableStatus(resp.StatusCode) && attempt < s.retryAttempts {
+			retry, created, err := s.retryRequest(req, resp.StatusCode, lookup)
+			if err != nil {
+				return nil, nil, err
+			}
+			if created != nil {
+				return created, nil, nil
+			}
+			if !retry {
+				return nil, resp.Header, newAPIError(req, resp.StatusCode, body)
+			}
+
+			wait := s.backoff(attempt, resp.Header.Get("Retry-After"))
+			tflog.Debug(req.Context(), "Retrying API request", map[string]interface{}{
+				"method":  req.Method,
+				"url":     req.URL.String(),
+				"status":  resp.StatusCode,
+				"attempt": attempt,
+				"wait":    wait.String(),
+			})
+			if err := s.waitForRetry(req, wait); err != nil {
+				return nil, nil, err
+			}
+			continue
+		}
+
+		// Short-lived bearer tokens may expire during a long apply, retry once
+		// with a refreshed token. A token that is still rejected is reported.
+		if resp.StatusCode == http.StatusUnauthorized && !refreshed && s.refreshBearerToken(req) {
+			refreshed = true
+			if err := s.waitForRetry(req, 0); err != nil {
+				return nil, nil, err
+			}
+			continue
+		}
+
+		// A missing object reads as an empty response, a missing object being
+		// deleted is reported so callers can tell it apart from a success.
+		if resp.StatusCode == http.StatusNotFound && req.Method != http.MethodDelete {
+			return nil, resp.Header, nil
+		}
+
+		if resp.StatusCode >= 400 {
+			return nil, resp.Header, newAPIError(req, resp.StatusCode, body)
+		}
+		// Responses without content, e.g. 204 No Content, have an empty rather
+		// than a nil body, which is reserved for missing objects.
+		if body == nil {
+			body = []byte{}
+		}
+		return body, resp.Header, nil
+	}
+}
+
+// logResponse logs an API request and its response at debug level. Bodies are
+// only included when NETBIRD_LOG_BODY is set, as they may contain secrets.
+func logResponse(req *http.Request, resp *http.Response, body []byte, attempt int, duration time.Duration) {
+	fields := map[string]interface{}{
+		"method":      req.Method,
+		"url":         req.URL.String(),
+		"headers":     redactedHeaders(req, "REDACTED"),
+		"status":      resp.StatusCode,
+		"attempt":     attempt,
+		"duration_ms": duration.Milliseconds(),
+	}
+	if logBody, _ := strconv.ParseBool(os.Getenv("NETBIRD_LOG_BODY")); logBody {
+		if requestBody, ok := requestBody(req); ok {
+			fields["request_body"] = requestBody
+		}
+		fields["response_body"] = string(body)
+	}
+	tflog.Debug(req.Context(), "NetBird API request", fields)
+}
+
+// recordRequest warns about requests slower than slowRequestThreshold and
+// counts requests by endpoint for each operation. The counts are logged once
+// the context of the operation is done, which the framework cancels when the
+// operation has completed.
+func (s *Client) recordRequest(req *http.Request, status int, duration time.Duration) {
+	ctx := req.Context()
+	endpoint := req.Method + " " + req.URL.Path
+	if s.slowRequestThreshold > 0 && duration > s.slowRequestThreshold {
+		tflog.Warn(ctx, "Slow NetBird API request", map[string]interface{}{
+			"method":       req.Method,
+			"path":         req.URL.Path,
+			"status":       status,
+			"duration_ms":  duration.Milliseconds(),
+			"threshold_ms": s.slowRequestThreshold.Milliseconds(),
+		})
+	}
+
+	// Contexts derived with only values added, e.g. by withPostRetry, share
+	// the Done channel of the operation. Contexts which are never done would
+	// never be logged.
+	done := ctx.Done()
+	if done == nil {
+		return
+	}
+
+	s.operationStatsMu.Lock()
+	defer s.operationStatsMu.Unlock()
+	if s.operationStats == nil {
+		s.operationStats = map[<-chan struct{}]map[string]*endpointStats{}
+	}
+	endpoints, ok := s.operationStats[done]
+	if !ok {
+		endpoints = map[string]*endpointStats{}
+		s.operationStats[done] = endpoints
+		context.AfterFunc(ctx, func() {
+			s.logOperationStats(ctx)
+		})
+	}
+	if endpoints[endpoint] == nil {
+		endpoints[endpoint] = &endpointStats{}
+	}
+	endpoints[endpoint].requests++
+	endpoints[endpoint].duration += duration
+}
+
+// logOperationStats logs the requests made by the operation of ctx by endpoint.
+func (s *Client) logOperationStats(ctx context.Context) {
+	s.operationStatsMu.Lock()
+	endpoints := s.operationStats[ctx.Done()]
+	delete(s.operationStats, ctx.Done())
+	s.operationStatsMu.Unlock()
+
+	total := 0
+	summary := map[string]string{}
+	for endpoint, stats := range endpoints {
+		total += stats.requests
+		summary[endpoint] = fmt.Sprintf("%d requests in %dms", stats.requests, stats.duration.Milliseconds())
+	}
+	tflog.Debug(ctx, "NetBird API requests of operation", map[string]interface{}{
+		"requests":  total,
+		"endpoints": summary,
+	})
+}
+
+// redactedHeaders returns the headers of req for logging, with the value of
+// the Authorization header, which holds the API token, replaced by authorization.
+func redactedHeaders(req *http.Request, authorization string) map[string]string {
+	headers := map[string]string{}
+	for name := range req.Header {
+		headers[name] = req.Header.Get(name)
+	}
+	if _, ok := headers["Authorization"]; ok {
+		headers["Authorization"] = authorization
+	}
+	return headers
+}
+
+// requestBody returns a copy of the body of req, reporting whether it has one.
+func requestBody(req *http.Request) (string, bool) {
+	if req.GetBody == nil {
+		return "", false
+	}
+	body, err := req.GetBody()
+	if err != nil {
+		return "", false
+	}
+	raw, _ := io.ReadAll(body)
+	return string(raw), true
+}
+
+// curlCommand returns a curl command line sending req, logged when
+// NETBIRD_DEBUG_CURL is set to reproduce requests. The API token is replaced
+// by a reference to the NETBIRD_TOKEN shell variable.
+func curlCommand(req *http.Request) string {
+	// Keep the scheme, e.g. Token or Bearer, so only the variable has to be set
+	scheme, _, _ := strings.Cut(req.Header.Get("Authorization"), " ")
+	headers := redactedHeaders(req, scheme+" $NETBIRD_TOKEN")
+
+	names := make([]string, 0, len(headers))
+	for name := range headers {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	command := []string{"curl", "-X", req.Method, shellQuote(req.URL.String())}
+	for _, name := range names {
+		if name == "Authorization" {
+			// Double quotes let the shell expand $NETBIRD_TOKEN
+			command = append(command, "-H", fmt.Sprintf(`"%s: %s"`, name, headers[name]))
+			continue
+		}
+		command = append(command, "-H", shellQuote(name+": "+headers[name]))
+	}
+	if body, ok := requestBody(req); ok {
+		command = append(command, "--data-raw", shellQuote(body))
+	}
+	return strings.Join(command, " ")
+}
+
+// shellQuote quotes value as a single argument for POSIX shells.
+func shellQuote(value string) string {
+	return "'" + strings.ReplaceAll(value, "'", `'\''`) + "'"
+}
+
+// retryableStatus reports whether a response status is worth retrying.
+func retryableStatus(status int) bool {
+	return status == http.StatusTooManyRequests || status >= 500
+}
+
+// preparePostRetry prepares the lookup of a POST request opted into retries
+// with withPostRetry. Nothing is prepared when the request is not retried, so
+// creating objects costs no extra requests then.
+func (s *Client) preparePostRetry(req *http.Request) (createdLookup, error) {
+	retry, ok := req.Context().Value(postRetryKey{}).(postRetry)
+	if !ok || req.Method != http.MethodPost || retry.prepareLookup == nil || s.retryAttempts <= 1 {
 		return nil, nil
 	}
+	lookup, err := retry.prepareLookup(req.Context())
+	if err != nil {
+		return nil, fmt.Errorf("error listing existing objects before creating one: %w", err)
+	}
+	return lookup, nil
+}
+
+// retryRequest reports whether req, which failed with a retryable status, may
+// be sent again. GET, PUT and DELETE requests are idempotent and always
+// retried, as are rate limited requests. Other POST requests are only retried
+// when opted into with withPostRetry, returning the object found by lookup
+// instead if the failed attempt created it.
+func (s *Client) retryRequest(req *http.Request, status int, lookup createdLookup) (bool, []byte, error) {
+	if req.Method != http.MethodPost || status == http.StatusTooManyRequests {
+		return true, nil, nil
+	}
+
+	if _, ok := req.Context().Value(postRetryKey{}).(postRetry); !ok {
+		tflog.Debug(req.Context(), "Not retrying API request which may have created an object", map[string]interface{}{
+			"method": req.Method,
+			"url":    req.URL.String(),
+			"status": status,
+		})
+		return false, nil, nil
+	}
+	if lookup == nil {
+		return true, nil, nil
+	}
+
+	// The failed request may have changed lists read before it
+	s.invalidateResponseCache()
+	created, err := lookup(req.Context())
+	if err != nil {
+		return false, nil, fmt.Errorf("error checking whether the failed request created an object: %w", err)
+	}
+	if created != nil {
+		tflog.Debug(req.Context(), "Failed API request created an object, not retrying", map[string]interface{}{
+			"method": req.Method,
+			"url":    req.URL.String(),
+			"status": status,
+		})
+		return false, created, nil
+	}
+	return true, nil, nil
+}
+
+// backoff returns how long to wait before the next attempt. A Retry-After
+// header takes precedence over exponential backoff, capped at retryWaitMax.
+func (s *Client) backoff(attempt int, retryAfter string) time.Duration {
+	if wait, ok := parseRetryAfter(retryAfter); ok {
+		return min(wait, s.retryWaitMax)
+	}
+
+	wait := s.retryWaitMin << (attempt - 1)
+	if wait <= 0 || wait > s.retryWaitMax {
+		wait = s.retryWaitMax
+	}
+	// Jitter within the upper half of the window spreads out retries from parallel resources.
+	half := wait / 2
+	return half + time.Duration(rand.Int63n(int64(half)+1))
+}
+
+// parseRetryAfter parses a Retry-After header in either delay-seconds or HTTP-date form.
+func parseRetryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		return max(time.Until(date), 0), true
+	}
+	return 0, false
+}
+
+// waitForRetry sleeps for wait and rewinds the request body so it can be sent again.
+func (s *Client) waitForRetry(req *http.Request, wait time.Duration) error {
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+	select {
+	case <-req.Context().Done():
+		return req.Context().Err()
+	case <-timer.C:
+	}
 
-	if resp.StatusCode >= 400 {
-		return nil, fmt.Errorf("%s", body)
+	if req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			return err
+		}
+		req.Body = body
 	}
-	return body, nil
+	return nil
 }
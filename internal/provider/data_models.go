@@ -9,6 +9,7 @@ type PeerDataSourceModel struct {
 	ConnectionIP                types.String               `tfsdk:"connection_ip"`
 	Connected                   types.Bool                 `tfsdk:"connected"`
 	LastSeen                    types.String               `tfsdk:"last_seen"`
+	LastSeenUnix                types.Int64                `tfsdk:"last_seen_unix"`
 	OS                          types.String               `tfsdk:"os"`
 	KernelVersion               types.String               `tfsdk:"kernel_version"`
 	GeonameID                   types.Int64                `tfsdk:"geoname_id"`
@@ -22,6 +23,7 @@ type PeerDataSourceModel struct {
 	LoginExpirationEnabled      types.Bool                 `tfsdk:"login_expiration_enabled"`
 	LoginExpired                types.Bool                 `tfsdk:"login_expired"`
 	LastLogin                   types.String               `tfsdk:"last_login"`
+	LastLoginUnix               types.Int64                `tfsdk:"last_login_unix"`
 	InactivityExpirationEnabled types.Bool                 `tfsdk:"inactivity_expiration_enabled"`
 	ApprovalRequired            types.Bool                 `tfsdk:"approval_required"`
 	CountryCode                 types.String               `tfsdk:"country_code"`
@@ -29,6 +31,8 @@ type PeerDataSourceModel struct {
 	SerialNumber                types.String               `tfsdk:"serial_number"`
 	ExtraDNSLabels              []types.String             `tfsdk:"extra_dns_labels"`
 	AccessiblePeersCount        types.Int64                `tfsdk:"accessible_peers_count"`
+	RegisteredAt                types.String               `tfsdk:"registered_at"`
+	IssuedBy                    types.String               `tfsdk:"issued_by"`
 }
 
 type PeerGroupDataSourceModel struct {
@@ -40,7 +44,154 @@ type PeerGroupDataSourceModel struct {
 }
 
 type PeersDataSourceModel struct {
-	Name  types.String          `tfsdk:"name"`
-	IP    types.String          `tfsdk:"ip"`
-	Peers []PeerDataSourceModel `tfsdk:"peers"`
+	Name             types.String          `tfsdk:"name"`
+	IP               types.String          `tfsdk:"ip"`
+	CountryCode      types.String          `tfsdk:"country_code"`
+	MinVersion       types.String          `tfsdk:"min_version"`
+	MaxVersion       types.String          `tfsdk:"max_version"`
+	ApprovalRequired types.Bool            `tfsdk:"approval_required"`
+	Peers            []PeerDataSourceModel `tfsdk:"peers"`
+}
+
+type NetworkDataSourceModel struct {
+	ID                types.String                     `tfsdk:"id"`
+	Name              types.String                     `tfsdk:"name"`
+	Description       types.String                     `tfsdk:"description"`
+	RoutingPeersCount types.Int64                      `tfsdk:"routing_peers_count"`
+	Routers           types.List                       `tfsdk:"routers"`
+	Resources         types.List                       `tfsdk:"resources"`
+	Policies          types.List                       `tfsdk:"policies"`
+	Expand            types.Bool                       `tfsdk:"expand"`
+	RouterDetails     []NetworkRouterDataSourceModel   `tfsdk:"router_details"`
+	ResourceDetails   []NetworkResourceDataSourceModel `tfsdk:"resource_details"`
+}
+
+type NetworkRouterDataSourceModel struct {
+	ID         types.String `tfsdk:"id"`
+	Peer       types.String `tfsdk:"peer"`
+	PeerGroups types.List   `tfsdk:"peer_groups"`
+	Metric     types.Int64  `tfsdk:"metric"`
+	Masquerade types.Bool   `tfsdk:"masquerade"`
+	Enabled    types.Bool   `tfsdk:"enabled"`
+}
+
+type NetworkResourceDataSourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	Address     types.String `tfsdk:"address"`
+	Type        types.String `tfsdk:"type"`
+	Groups      types.List   `tfsdk:"groups"`
+	Enabled     types.Bool   `tfsdk:"enabled"`
+}
+
+type NetworkPoliciesDataSourceModel struct {
+	NetworkID types.String                   `tfsdk:"network_id"`
+	Policies  []NetworkPolicyDataSourceModel `tfsdk:"policies"`
+}
+
+type NetworkPolicyDataSourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	Enabled     types.Bool   `tfsdk:"enabled"`
+	RulesCount  types.Int64  `tfsdk:"rules_count"`
+}
+
+type RoutingPeersDataSourceModel struct {
+	TotalRoutingPeersCount types.Int64                          `tfsdk:"total_routing_peers_count"`
+	Networks               []NetworkRoutingPeersDataSourceModel `tfsdk:"networks"`
+}
+
+type NetworkRoutingPeersDataSourceModel struct {
+	ID                types.String `tfsdk:"id"`
+	Name              types.String `tfsdk:"name"`
+	RoutingPeersCount types.Int64  `tfsdk:"routing_peers_count"`
+}
+
+type GroupByPeersDataSourceModel struct {
+	PeerIDs        types.List   `tfsdk:"peer_ids"`
+	Strict         types.Bool   `tfsdk:"strict"`
+	ID             types.String `tfsdk:"id"`
+	Name           types.String `tfsdk:"name"`
+	Peers          types.List   `tfsdk:"peers"`
+	PeersCount     types.Int64  `tfsdk:"peers_count"`
+	ResourcesCount types.Int64  `tfsdk:"resources_count"`
+	Issued         types.String `tfsdk:"issued"`
+}
+
+type GroupsDataSourceModel struct {
+	Name   types.String           `tfsdk:"name"`
+	Issued types.String           `tfsdk:"issued"`
+	Groups []GroupDataSourceModel `tfsdk:"groups"`
+}
+
+type GroupDataSourceModel struct {
+	ID             types.String               `tfsdk:"id"`
+	Name           types.String               `tfsdk:"name"`
+	Peers          []GroupPeerDataSourceModel `tfsdk:"peers"`
+	PeerIDs        []types.String             `tfsdk:"peer_ids"`
+	PeersCount     types.Int64                `tfsdk:"peers_count"`
+	ResourcesCount types.Int64                `tfsdk:"resources_count"`
+	Issued         types.String               `tfsdk:"issued"`
+}
+
+type GroupPeerDataSourceModel struct {
+	ID   types.String `tfsdk:"id"`
+	Name types.String `tfsdk:"name"`
+}
+
+type UserTokensDataSourceModel struct {
+	UserID types.String               `tfsdk:"user_id"`
+	Tokens []UserTokenDataSourceModel `tfsdk:"tokens"`
+}
+
+type UserTokenDataSourceModel struct {
+	ID             types.String `tfsdk:"id"`
+	Name           types.String `tfsdk:"name"`
+	CreatedAt      types.String `tfsdk:"created_at"`
+	ExpirationDate types.String `tfsdk:"expiration_date"`
+	LastUsed       types.String `tfsdk:"last_used"`
+}
+
+type UsersDataSourceModel struct {
+	Role          types.String          `tfsdk:"role"`
+	IsServiceUser types.Bool            `tfsdk:"is_service_user"`
+	IDs           []types.String        `tfsdk:"ids"`
+	Users         []UserDataSourceModel `tfsdk:"users"`
+}
+
+type UserDataSourceModel struct {
+	ID            types.String   `tfsdk:"id"`
+	Email         types.String   `tfsdk:"email"`
+	Name          types.String   `tfsdk:"name"`
+	Role          types.String   `tfsdk:"role"`
+	Status        types.String   `tfsdk:"status"`
+	IsServiceUser types.Bool     `tfsdk:"is_service_user"`
+	IsBlocked     types.Bool     `tfsdk:"is_blocked"`
+	AutoGroups    []types.String `tfsdk:"auto_groups"`
+	LastLogin     types.String   `tfsdk:"last_login"`
+}
+
+type RoutesDataSourceModel struct {
+	Enabled     types.Bool             `tfsdk:"enabled"`
+	NetworkType types.String           `tfsdk:"network_type"`
+	Routes      []RouteDataSourceModel `tfsdk:"routes"`
+}
+
+type RouteDataSourceModel struct {
+	ID                  types.String `tfsdk:"id"`
+	NetworkID           types.String `tfsdk:"network_id"`
+	Description         types.String `tfsdk:"description"`
+	Network             types.String `tfsdk:"network"`
+	Domains             types.List   `tfsdk:"domains"`
+	KeepRoute           types.Bool   `tfsdk:"keep_route"`
+	NetworkType         types.String `tfsdk:"network_type"`
+	Peer                types.String `tfsdk:"peer"`
+	PeerGroups          types.List   `tfsdk:"peer_groups"`
+	Groups              types.List   `tfsdk:"groups"`
+	AccessControlGroups types.List   `tfsdk:"access_control_groups"`
+	Metric              types.Int64  `tfsdk:"metric"`
+	Masquerade          types.Bool   `tfsdk:"masquerade"`
+	Enabled             types.Bool   `tfsdk:"enabled"`
 }
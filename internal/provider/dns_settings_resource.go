@@ -1,11 +1,8 @@
 package provider
 
 import (
-	"bytes"
 	"context"
-	"encoding/json"
 	"fmt"
-	"net/http"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -27,7 +24,7 @@ func NewDnsSettingsResource() resource.Resource {
 
 // DnsSettingsResource defines the resource implementation.
 type DnsSettingsResource struct {
-	client *Client
+	client ClientInterface
 }
 
 type DnsSettingsResourceModel struct {
@@ -42,12 +39,12 @@ func (r *DnsSettingsResource) Metadata(ctx context.Context, req resource.Metadat
 func (r *DnsSettingsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		// This description is used by the documentation generator and the language server.
-		MarkdownDescription: "DNS Settings resource",
+		MarkdownDescription: "DNS Settings resource. Import with any ID, e.g. `terraform import netbird_dns_settings.this dns-settings`.",
 
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Computed:            true,
-				MarkdownDescription: "Config ID",
+				MarkdownDescription: "Always `dns-settings`",
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
 				},
@@ -67,7 +64,7 @@ func (r *DnsSettingsResource) Configure(ctx context.Context, req resource.Config
 		return
 	}
 
-	client, ok := req.ProviderData.(*Client)
+	client, ok := req.ProviderData.(ClientInterface)
 
 	if !ok {
 		resp.Diagnostics.AddError(
@@ -88,33 +85,19 @@ func dnsSettingsModelToApi(data *DnsSettingsResourceModel) (netbirdApi.DNSSettin
 	return apiModel, diags
 }
 
-func (r *DnsSettingsResource) updateDnsSettings(data *DnsSettingsResourceModel) ([]byte, diag.Diagnostics) {
+func (r *DnsSettingsResource) updateDnsSettings(ctx context.Context, data *DnsSettingsResourceModel) (*netbirdApi.DNSSettings, diag.Diagnostics) {
 	apiModel, diags := dnsSettingsModelToApi(data)
 	if diags.HasError() {
 		return nil, diags
 	}
 
-	requestBody, err := json.Marshal(apiModel)
-	if err != nil {
-		diags.AddError("Error marshaling request body", err.Error())
-		return nil, diags
-	}
-
 	// Make API request
-	reqURL := fmt.Sprintf("%s/api/dns/settings", r.client.BaseUrl)
-	httpReq, err := http.NewRequest("PUT", reqURL, bytes.NewBuffer(requestBody))
-	if err != nil {
-		diags.AddError("Error creating request", err.Error())
-		return nil, diags
-	}
-	httpReq.Header.Set("Content-Type", "application/json")
-
-	responseBody, err := r.client.doRequest(httpReq)
+	responseData, err := putJSON[netbirdApi.DNSSettings](ctx, r.client, "/api/dns/settings", apiModel)
 	if err != nil {
 		diags.AddError("Error making API request", err.Error())
 		return nil, diags
 	}
-	return responseBody, diags
+	return responseData, diags
 }
 
 func (r *DnsSettingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -127,19 +110,12 @@ func (r *DnsSettingsResource) Create(ctx context.Context, req resource.CreateReq
 		return
 	}
 
-	responseBody, diags := r.updateDnsSettings(&data)
+	_, diags := r.updateDnsSettings(ctx, &data)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	// Parse response
-	var responseData map[string]interface{}
-	if err := json.Unmarshal(responseBody, &responseData); err != nil {
-		resp.Diagnostics.AddError("Error parsing response", err.Error())
-		return
-	}
-
 	// Assign hard coded value
 	data.ID = types.StringValue("dns-settings")
 
@@ -169,6 +145,12 @@ func (r *DnsSettingsResource) Read(ctx context.Context, req resource.ReadRequest
 		return
 	}
 
+	// The object has been deleted outside of Terraform
+	if data.ID.IsNull() {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -177,31 +159,18 @@ func (r *DnsSettingsResource) readDnsSettingsIntoModel(ctx context.Context, data
 	// Update network model
 	// Fetch data from API
 	diags := diag.Diagnostics{}
-	reqURL := fmt.Sprintf("%s/api/dns/settings", r.client.BaseUrl)
-	httpReq, err := http.NewRequest("GET", reqURL, nil)
-	if err != nil {
-		diags.AddError("Error creating request", err.Error())
-		return diags
-	}
-
-	responseBody, err := r.client.doRequest(httpReq)
+	responseData, err := getJSON[netbirdApi.DNSSettings](ctx, r.client, "/api/dns/settings")
 	if err != nil {
 		diags.AddError("Error fetching network", err.Error())
 		return diags
 	}
 
 	// Handle when resource does not exist
-	if responseBody == nil {
+	if responseData == nil {
 		data.ID = types.StringNull()
 		return diags
 	}
 
-	var responseData netbirdApi.DNSSettings
-	if err := json.Unmarshal(responseBody, &responseData); err != nil {
-		diags.AddError("Error parsing response", err.Error())
-		return diags
-	}
-
 	disabledManagementGroups, newDiags := types.ListValueFrom(ctx, types.StringType, responseData.DisabledManagementGroups)
 	diags.Append(newDiags...)
 	data.DisabledManagementGroups = disabledManagementGroups
@@ -219,7 +188,7 @@ func (r *DnsSettingsResource) Update(ctx context.Context, req resource.UpdateReq
 		return
 	}
 
-	_, diags := r.updateDnsSettings(&data)
+	_, diags := r.updateDnsSettings(ctx, &data)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -245,23 +214,9 @@ func (r *DnsSettingsResource) Delete(ctx context.Context, req resource.DeleteReq
 		return
 	}
 
-	requestBody, err := json.Marshal(netbirdApi.DNSSettings{
+	_, err := putJSON[netbirdApi.DNSSettings](ctx, r.client, "/api/dns/settings", netbirdApi.DNSSettings{
 		DisabledManagementGroups: []string{},
 	})
-	if err != nil {
-		resp.Diagnostics.AddError("Error marshaling request body", err.Error())
-		return
-	}
-
-	reqURL := fmt.Sprintf("%s/api/dns/settings", r.client.BaseUrl)
-	httpReq, err := http.NewRequest("PUT", reqURL, bytes.NewBuffer(requestBody))
-	if err != nil {
-		resp.Diagnostics.AddError("Error creating request", err.Error())
-		return
-	}
-	httpReq.Header.Set("Content-Type", "application/json")
-
-	_, err = r.client.doRequest(httpReq)
 	if err != nil {
 		resp.Diagnostics.AddError("Error updating network", err.Error())
 		return
@@ -271,5 +226,6 @@ func (r *DnsSettingsResource) Delete(ctx context.Context, req resource.DeleteReq
 }
 
 func (r *DnsSettingsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	// There is a single DNS settings object, so any import ID refers to it.
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), "dns-settings")...)
 }
@@ -0,0 +1,54 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDnsSettingsResource(t *testing.T) {
+	providerConfig, _ := testAccProviderConfig(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+resource "netbird_group" "test" {
+  name = "tf-acc-dns-settings"
+}
+
+resource "netbird_dns_settings" "test" {
+  disabled_management_groups = [netbird_group.test.id]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("netbird_dns_settings.test", "id", "dns-settings"),
+					resource.TestCheckResourceAttr("netbird_dns_settings.test", "disabled_management_groups.#", "1"),
+					resource.TestCheckResourceAttrPair("netbird_dns_settings.test", "disabled_management_groups.0", "netbird_group.test", "id"),
+				),
+			},
+			// ImportState testing with an arbitrary import ID
+			{
+				ResourceName:      "netbird_dns_settings.test",
+				ImportState:       true,
+				ImportStateId:     "anything",
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + `
+resource "netbird_dns_settings" "test" {
+  disabled_management_groups = []
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("netbird_dns_settings.test", "disabled_management_groups.#", "0"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
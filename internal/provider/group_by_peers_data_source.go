@@ -0,0 +1,175 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	netbirdApi "github.com/netbirdio/netbird/management/server/http/api"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &GroupByPeersDataSource{}
+
+func NewGroupByPeersDataSource() datasource.DataSource {
+	return &GroupByPeersDataSource{}
+}
+
+// GroupByPeersDataSource looks up a group by the peers it contains.
+type GroupByPeersDataSource struct {
+	client ClientInterface
+}
+
+func (d *GroupByPeersDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_group_by_peers"
+}
+
+func (d *GroupByPeersDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Retrieve the first group containing all of the given peers, in the order the API lists groups. " +
+			"The `All` group contains every peer, set `strict` to only match a group with exactly the given peers.",
+
+		Attributes: map[string]schema.Attribute{
+			"peer_ids": schema.ListAttribute{
+				ElementType: types.StringType,
+				Required:    true,
+				Description: "IDs of the peers the group must contain.",
+			},
+			"strict": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Only match a group containing exactly the given peers and no others. Defaults to false.",
+			},
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Unique identifier of the group.",
+			},
+			"name": schema.StringAttribute{
+				Computed:    true,
+				Description: "Name of the group.",
+			},
+			"peers": schema.ListAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+				Description: "IDs of all peers in the group.",
+			},
+			"peers_count": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Number of peers in the group.",
+			},
+			"resources_count": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Number of network resources in the group.",
+			},
+			"issued": schema.StringAttribute{
+				Computed:    true,
+				Description: "How the group was issued (api, integration, jwt).",
+			},
+		},
+	}
+}
+
+func (d *GroupByPeersDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(ClientInterface)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *GroupByPeersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data GroupByPeersDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var peerIDs []string
+	resp.Diagnostics.Append(data.PeerIDs.ElementsAs(ctx, &peerIDs, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if len(peerIDs) == 0 {
+		resp.Diagnostics.AddAttributeError(path.Root("peer_ids"), "Invalid Peer IDs", "At least one peer ID must be given, otherwise every group would match.")
+		return
+	}
+
+	groups, err := listGroups(ctx, d.client)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Making API Request", err.Error())
+		return
+	}
+
+	group := findGroupByPeers(groups, peerIDs, data.Strict.ValueBool())
+	if group == nil {
+		resp.Diagnostics.AddError("Group Not Found", fmt.Sprintf("No group contains the peers %s.", strings.Join(peerIDs, ", ")))
+		return
+	}
+
+	groupPeers := make([]string, 0, len(group.Peers))
+	for _, peer := range group.Peers {
+		groupPeers = append(groupPeers, peer.Id)
+	}
+	peers, diags := types.ListValueFrom(ctx, types.StringType, groupPeers)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = types.StringValue(group.Id)
+	data.Name = types.StringValue(group.Name)
+	data.Peers = peers
+	data.PeersCount = types.Int64Value(int64(group.PeersCount))
+	data.ResourcesCount = types.Int64Value(int64(group.ResourcesCount))
+	data.Issued = types.StringNull()
+	if group.Issued != nil {
+		data.Issued = types.StringValue(string(*group.Issued))
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// findGroupByPeers returns the first group containing every peer in peerIDs,
+// and no other peers when strict, or nil if there is none.
+func findGroupByPeers(groups []netbirdApi.Group, peerIDs []string, strict bool) *netbirdApi.Group {
+	wanted := map[string]bool{}
+	for _, id := range peerIDs {
+		wanted[id] = true
+	}
+
+	for i, group := range groups {
+		found := map[string]bool{}
+		extra := false
+		for _, peer := range group.Peers {
+			if wanted[peer.Id] {
+				found[peer.Id] = true
+			} else {
+				extra = true
+			}
+		}
+		if len(found) == len(wanted) && !(strict && extra) {
+			return &groups[i]
+		}
+	}
+	return nil
+}
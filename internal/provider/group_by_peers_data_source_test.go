@@ -0,0 +1,99 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	netbirdApi "github.com/netbirdio/netbird/management/server/http/api"
+)
+
+func TestAccGroupByPeersDataSource(t *testing.T) {
+	testAccMockOnly(t)
+	providerConfig, mock := testAccProviderConfig(t)
+	peerA := mock.Seed("/api/peers", netbirdApi.PeerBatch{Name: "tf-acc-peer-a"})
+	peerB := mock.Seed("/api/peers", netbirdApi.PeerBatch{Name: "tf-acc-peer-b"})
+	peerC := mock.Seed("/api/peers", netbirdApi.PeerBatch{Name: "tf-acc-peer-c"})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + fmt.Sprintf(`
+resource "netbird_group" "all" {
+  name  = "tf-acc-all"
+  peers = [%[1]q, %[2]q, %[3]q]
+}
+
+# Created after the other group, so it is listed second
+resource "netbird_group" "pair" {
+  name  = "tf-acc-pair"
+  peers = [%[1]q, %[2]q]
+
+  depends_on = [netbird_group.all]
+}
+
+data "netbird_group_by_peers" "superset" {
+  peer_ids = [%[2]q]
+
+  depends_on = [netbird_group.all, netbird_group.pair]
+}
+
+data "netbird_group_by_peers" "strict" {
+  peer_ids = [%[2]q, %[1]q]
+  strict   = true
+
+  depends_on = [netbird_group.all, netbird_group.pair]
+}
+`, peerA, peerB, peerC),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.netbird_group_by_peers.superset", "id", "netbird_group.all", "id"),
+					resource.TestCheckResourceAttr("data.netbird_group_by_peers.superset", "peers_count", "3"),
+					resource.TestCheckResourceAttrPair("data.netbird_group_by_peers.strict", "id", "netbird_group.pair", "id"),
+					resource.TestCheckResourceAttr("data.netbird_group_by_peers.strict", "name", "tf-acc-pair"),
+					resource.TestCheckResourceAttr("data.netbird_group_by_peers.strict", "peers.#", "2"),
+					resource.TestCheckResourceAttr("data.netbird_group_by_peers.strict", "issued", "api"),
+				),
+			},
+			{
+				Config: providerConfig + fmt.Sprintf(`
+data "netbird_group_by_peers" "missing" {
+  peer_ids = [%q]
+  strict   = true
+}
+`, peerC),
+				ExpectError: regexp.MustCompile("Group Not Found"),
+			},
+		},
+	})
+}
+
+func TestFindGroupByPeers(t *testing.T) {
+	groups := []netbirdApi.Group{
+		{Id: "all", Peers: []netbirdApi.PeerMinimum{{Id: "a"}, {Id: "b"}, {Id: "c"}}},
+		{Id: "pair", Peers: []netbirdApi.PeerMinimum{{Id: "b"}, {Id: "a"}}},
+		{Id: "empty"},
+	}
+	for _, tc := range []struct {
+		peerIDs  []string
+		strict   bool
+		expected string
+	}{
+		{[]string{"a"}, false, "all"},
+		{[]string{"a", "b"}, true, "pair"},
+		{[]string{"a", "a", "b"}, true, "pair"},
+		{[]string{"a"}, true, ""},
+		{[]string{"d"}, false, ""},
+	} {
+		group := findGroupByPeers(groups, tc.peerIDs, tc.strict)
+		got := ""
+		if group != nil {
+			got = group.Id
+		}
+		if got != tc.expected {
+			t.Errorf("findGroupByPeers(%v, strict=%t) = %q, expected %q", tc.peerIDs, tc.strict, got, tc.expected)
+		}
+	}
+}
@@ -0,0 +1,163 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	netbirdApi "github.com/netbirdio/netbird/management/server/http/api"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &GroupDataSource{}
+
+func NewGroupDataSource() datasource.DataSource {
+	return &GroupDataSource{}
+}
+
+// GroupDataSource retrieves a group and its peers.
+type GroupDataSource struct {
+	client ClientInterface
+}
+
+func (d *GroupDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_group"
+}
+
+func (d *GroupDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Retrieve group details, including the names of its peers.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Required:    true,
+				Description: "Unique identifier of the group.",
+				Validators: []validator.String{
+					objectIDValidator,
+				},
+			},
+		},
+	}
+	for name, attribute := range groupDataSourceAttributes() {
+		resp.Schema.Attributes[name] = attribute
+	}
+}
+
+// groupDataSourceAttributes returns the computed attributes shared by the data
+// sources that return groups, except id.
+func groupDataSourceAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"name": schema.StringAttribute{
+			Computed:    true,
+			Description: "Name of the group.",
+		},
+		"peers": schema.ListNestedAttribute{
+			Computed:    true,
+			Description: "Peers in the group.",
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"id": schema.StringAttribute{
+						Computed:    true,
+						Description: "Unique identifier of the peer.",
+					},
+					"name": schema.StringAttribute{
+						Computed:    true,
+						Description: "Name of the peer.",
+					},
+				},
+			},
+		},
+		"peer_ids": schema.ListAttribute{
+			ElementType: types.StringType,
+			Computed:    true,
+			Description: "IDs of the peers in the group.",
+		},
+		"peers_count": schema.Int64Attribute{
+			Computed:    true,
+			Description: "Number of peers in the group.",
+		},
+		"resources_count": schema.Int64Attribute{
+			Computed:    true,
+			Description: "Number of network resources in the group.",
+		},
+		"issued": schema.StringAttribute{
+			Computed:    true,
+			Description: "How the group was issued (api, integration, jwt).",
+		},
+	}
+}
+
+func (d *GroupDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(ClientInterface)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *GroupDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data GroupDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	endpoint := fmt.Sprintf("/api/groups/%s", data.ID.ValueString())
+
+	group, err := getJSON[netbirdApi.Group](ctx, d.client, endpoint)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Making API Request: "+endpoint, err.Error())
+		return
+	}
+	if group == nil {
+		resp.Diagnostics.AddError("Group Not Found", fmt.Sprintf("No group found with ID %q", data.ID.ValueString()))
+		return
+	}
+	data = convertGroupToDataSourceModel(*group)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// convertGroupToDataSourceModel maps a group returned by the API to its data source model.
+func convertGroupToDataSourceModel(group netbirdApi.Group) GroupDataSourceModel {
+	model := GroupDataSourceModel{
+		ID:             types.StringValue(group.Id),
+		Name:           types.StringValue(group.Name),
+		Peers:          []GroupPeerDataSourceModel{},
+		PeerIDs:        []types.String{},
+		PeersCount:     types.Int64Value(int64(group.PeersCount)),
+		ResourcesCount: types.Int64Value(int64(group.ResourcesCount)),
+		Issued:         types.StringNull(),
+	}
+	for _, peer := range group.Peers {
+		model.Peers = append(model.Peers, GroupPeerDataSourceModel{
+			ID:   types.StringValue(peer.Id),
+			Name: types.StringValue(peer.Name),
+		})
+		model.PeerIDs = append(model.PeerIDs, types.StringValue(peer.Id))
+	}
+	if group.Issued != nil {
+		model.Issued = types.StringValue(string(*group.Issued))
+	}
+	return model
+}
@@ -0,0 +1,70 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	netbirdApi "github.com/netbirdio/netbird/management/server/http/api"
+)
+
+func TestAccGroupDataSource(t *testing.T) {
+	testAccMockOnly(t)
+	providerConfig, mock := testAccProviderConfig(t)
+	dbPeerID := mock.Seed("/api/peers", netbirdApi.PeerBatch{Name: "tf-acc-db-1"})
+	replicaPeerID := mock.Seed("/api/peers", netbirdApi.PeerBatch{Name: "tf-acc-db-2"})
+	groupID := mock.Seed("/api/groups", map[string]any{"name": "tf-acc-prod-db-access", "issued": "jwt", "peers": []string{dbPeerID, replicaPeerID}})
+	emptyGroupID := mock.Seed("/api/groups", map[string]any{"name": "tf-acc-empty"})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + fmt.Sprintf(`
+data "netbird_group" "test" {
+  id = %q
+}
+
+data "netbird_group" "empty" {
+  id = %q
+}
+`, groupID, emptyGroupID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.netbird_group.test", "name", "tf-acc-prod-db-access"),
+					resource.TestCheckResourceAttr("data.netbird_group.test", "issued", "jwt"),
+					resource.TestCheckResourceAttr("data.netbird_group.test", "peers_count", "2"),
+					resource.TestCheckResourceAttr("data.netbird_group.test", "peers.#", "2"),
+					resource.TestCheckResourceAttr("data.netbird_group.test", "peers.0.id", dbPeerID),
+					resource.TestCheckResourceAttr("data.netbird_group.test", "peers.0.name", "tf-acc-db-1"),
+					resource.TestCheckResourceAttr("data.netbird_group.test", "peers.1.name", "tf-acc-db-2"),
+					resource.TestCheckResourceAttr("data.netbird_group.test", "peer_ids.#", "2"),
+					resource.TestCheckResourceAttr("data.netbird_group.test", "peer_ids.1", replicaPeerID),
+					resource.TestCheckResourceAttr("data.netbird_group.empty", "peers.#", "0"),
+					resource.TestCheckResourceAttr("data.netbird_group.empty", "peer_ids.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccGroupDataSource_notFound(t *testing.T) {
+	testAccMockOnly(t)
+	providerConfig, _ := testAccProviderConfig(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+data "netbird_group" "test" {
+  id = "missing"
+}
+`,
+				ExpectError: regexp.MustCompile("No group found with ID"),
+			},
+		},
+	})
+}
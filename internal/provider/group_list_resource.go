@@ -0,0 +1,124 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	netbirdApi "github.com/netbirdio/netbird/management/server/http/api"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ list.ListResource = &GroupListResource{}
+var _ list.ListResourceWithConfigure = &GroupListResource{}
+
+func NewGroupListResource() list.ListResource {
+	return &GroupListResource{}
+}
+
+// GroupListResource lists groups so they can be found by `terraform query`.
+type GroupListResource struct {
+	client ClientInterface
+}
+
+type GroupListResourceModel struct {
+	Issued types.String `tfsdk:"issued"`
+}
+
+func (r *GroupListResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_group"
+}
+
+func (r *GroupListResource) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = listschema.Schema{
+		MarkdownDescription: "Lists groups, e.g. to generate import blocks for groups created in the dashboard.",
+
+		Attributes: map[string]listschema.Attribute{
+			"issued": listschema.StringAttribute{
+				MarkdownDescription: "Only list groups issued this way: `api` for groups created in the dashboard or API, `jwt` for groups synced from JWT claims or `integration` for groups synced from an identity provider",
+				Optional:            true,
+				Validators: []validator.String{
+					groupIssuedValidator,
+				},
+			},
+		},
+	}
+}
+
+func (r *GroupListResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(ClientInterface)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *GroupListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var data GroupListResourceModel
+
+	// Read Terraform configuration data into the model
+	diags := req.Config.Get(ctx, &data)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	groups, err := getJSON[[]netbirdApi.Group](ctx, r.client, "/api/groups")
+	if err != nil {
+		diags.AddError("Error Making API Request", err.Error())
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+	if groups == nil {
+		groups = &[]netbirdApi.Group{}
+	}
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		for _, group := range *groups {
+			// The API can't filter by how groups are issued, so filter client-side
+			if !data.Issued.IsNull() && (group.Issued == nil || string(*group.Issued) != data.Issued.ValueString()) {
+				continue
+			}
+
+			result := req.NewListResult(ctx)
+			result.DisplayName = group.Name
+			result.Diagnostics.Append(setIDIdentity(ctx, result.Identity, types.StringValue(group.Id))...)
+			if req.IncludeResource {
+				model := GroupResourceModel{Timeouts: timeouts.Value{Object: types.ObjectNull(groupTimeoutsAttributeTypes)}}
+				result.Diagnostics.Append(groupIntoModel(ctx, group, &model)...)
+				result.Diagnostics.Append(result.Resource.Set(ctx, &model)...)
+			}
+
+			if !push(result) {
+				return
+			}
+		}
+	}
+}
+
+// groupTimeoutsAttributeTypes are the attributes of the timeouts block of
+// groups, which is null in listed groups.
+var groupTimeoutsAttributeTypes = map[string]attr.Type{
+	"create": types.StringType,
+	"read":   types.StringType,
+	"update": types.StringType,
+	"delete": types.StringType,
+}
@@ -0,0 +1,83 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck/queryfilter"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccGroupListResource(t *testing.T) {
+	testAccMockOnly(t)
+	providerConfig, mock := testAccProviderConfig(t)
+	apiGroupID := mock.Seed("/api/groups", map[string]any{"name": "tf-acc-dashboard", "issued": "api"})
+	jwtGroupID := mock.Seed("/api/groups", map[string]any{"name": "tf-acc-jwt", "issued": "jwt"})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Query: true,
+				Config: providerConfig + `
+list "netbird_group" "all" {
+  provider = netbird
+}
+`,
+				QueryResultChecks: []querycheck.QueryResultCheck{
+					querycheck.ExpectLength("netbird_group.all", 2),
+					querycheck.ExpectIdentity("netbird_group.all", map[string]knownvalue.Check{
+						"id": knownvalue.StringExact(jwtGroupID),
+					}),
+				},
+			},
+			// Only groups created in the dashboard or API
+			{
+				Query: true,
+				Config: providerConfig + `
+list "netbird_group" "api" {
+  provider         = netbird
+  include_resource = true
+
+  config {
+    issued = "api"
+  }
+}
+`,
+				QueryResultChecks: []querycheck.QueryResultCheck{
+					querycheck.ExpectLength("netbird_group.api", 1),
+					querycheck.ExpectResourceDisplayName("netbird_group.api", queryfilter.ByResourceIdentity(map[string]knownvalue.Check{
+						"id": knownvalue.StringExact(apiGroupID),
+					}), knownvalue.StringExact("tf-acc-dashboard")),
+					querycheck.ExpectResourceKnownValues("netbird_group.api", queryfilter.ByResourceIdentity(map[string]knownvalue.Check{
+						"id": knownvalue.StringExact(apiGroupID),
+					}), []querycheck.KnownValueCheck{
+						{Path: tfjsonpath.New("name"), KnownValue: knownvalue.StringExact("tf-acc-dashboard")},
+						{Path: tfjsonpath.New("issued"), KnownValue: knownvalue.StringExact("api")},
+					}),
+				},
+			},
+			{
+				Query: true,
+				Config: providerConfig + `
+list "netbird_group" "invalid" {
+  provider = netbird
+
+  config {
+    issued = "manual"
+  }
+}
+`,
+				ExpectError: regexp.MustCompile(`must be one of api, jwt or integration`),
+			},
+		},
+	})
+}
@@ -1,12 +1,11 @@
 package provider
 
 import (
-	"bytes"
 	"context"
-	"encoding/json"
 	"fmt"
-	"net/http"
+	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -20,6 +19,7 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &GroupResource{}
 var _ resource.ResourceWithImportState = &GroupResource{}
+var _ resource.ResourceWithIdentity = &GroupResource{}
 
 func NewGroupResource() resource.Resource {
 	return &GroupResource{}
@@ -27,7 +27,7 @@ func NewGroupResource() resource.Resource {
 
 // GroupResource defines the resource implementation.
 type GroupResource struct {
-	client *Client
+	client ClientInterface
 }
 
 // Group resource (resource) model
@@ -45,6 +45,7 @@ type GroupResourceModel struct {
 	PeersCount     types.Int64                  `tfsdk:"peers_count"`
 	ResourcesCount types.Int64                  `tfsdk:"resources_count"`
 	Issued         types.String                 `tfsdk:"issued"`
+	Timeouts       timeouts.Value               `tfsdk:"timeouts"`
 }
 
 func (r *GroupResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -90,28 +91,50 @@ func (r *GroupResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				},
 			},
 			"peers_count": schema.Int64Attribute{
-				Computed:            true,
-				MarkdownDescription: "Count of peers associated with the group.",
+				Computed: true,
+				MarkdownDescription: "Count of peers associated with the group, including peers added outside of Terraform. " +
+					"Refreshed on every plan, e.g. for a `precondition` requiring the group to have peers.",
+				PlanModifiers: []planmodifier.Int64{
+					int64UseStateUnlessChanged(path.Root("peers"), path.Root("resources")),
+				},
 			},
 			"resources_count": schema.Int64Attribute{
 				Computed:            true,
 				MarkdownDescription: "Count of resources associated with the group.",
+				PlanModifiers: []planmodifier.Int64{
+					int64UseStateUnlessChanged(path.Root("peers"), path.Root("resources")),
+				},
 			},
 			"issued": schema.StringAttribute{
 				Computed:            true,
 				MarkdownDescription: "How the group was issued (e.g., `api`, `integration`, `jwt`).",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
+func (r *GroupResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentitySchema("group")
+}
+
 func (r *GroupResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	// Prevent panic if the provider has not been configured.
 	if req.ProviderData == nil {
 		return
 	}
 
-	client, ok := req.ProviderData.(*Client)
+	client, ok := req.ProviderData.(ClientInterface)
 
 	if !ok {
 		resp.Diagnostics.AddError(
@@ -135,6 +158,14 @@ func (r *GroupResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
+	createTimeout, diags := data.Timeouts.Create(ctx, 0)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := timeoutContext(ctx, createTimeout)
+	defer cancel()
+
 	// Convert Terraform list of peers to a Go slice
 	var peersList []string
 	resp.Diagnostics.Append(data.Peers.ElementsAs(ctx, &peersList, false)...)
@@ -151,39 +182,21 @@ func (r *GroupResource) Create(ctx context.Context, req resource.CreateRequest,
 		})
 	}
 
-	// Prepare request body
-	requestBody, err := json.Marshal(netbirdApi.GroupRequest{
+	// Retry failed requests unless they created the group, which would
+	// otherwise be created twice
+	retryCtx := withPostRetry(ctx, lookupCreatedByName(r.client, "/api/groups", data.Name.ValueString()))
+
+	// API request
+	responseData, err := postJSON[netbirdApi.Group](retryCtx, r.client, "/api/groups", netbirdApi.GroupRequest{
 		Name:      data.Name.ValueString(),
 		Peers:     &peersList,
 		Resources: &resourcesList,
 	})
-	if err != nil {
-		resp.Diagnostics.AddError("Error marshaling request body", err.Error())
-		return
-	}
-
-	// API request
-	reqURL := fmt.Sprintf("%s/api/groups", r.client.BaseUrl)
-	httpReq, err := http.NewRequest("POST", reqURL, bytes.NewBuffer(requestBody))
-	if err != nil {
-		resp.Diagnostics.AddError("Error creating request", err.Error())
-		return
-	}
-	httpReq.Header.Set("Content-Type", "application/json")
-
-	responseBody, err := r.client.doRequest(httpReq)
 	if err != nil {
 		resp.Diagnostics.AddError("Error creating group", err.Error())
 		return
 	}
 
-	// Parse response
-	var responseData netbirdApi.Group
-	if err := json.Unmarshal(responseBody, &responseData); err != nil {
-		resp.Diagnostics.AddError("Error parsing response", err.Error())
-		return
-	}
-
 	// Set state values
 	data.ID = types.StringValue(responseData.Id)
 	data.PeersCount = types.Int64Value(int64(responseData.PeersCount))
@@ -197,7 +210,6 @@ func (r *GroupResource) Create(ctx context.Context, req resource.CreateRequest,
 	for _, peer := range responseData.Peers {
 		updatedPeersList = append(updatedPeersList, peer.Id)
 	}
-	var diags diag.Diagnostics
 	data.Peers, diags = types.ListValueFrom(ctx, types.StringType, updatedPeersList)
 	resp.Diagnostics.Append(diags...)
 
@@ -212,6 +224,7 @@ func (r *GroupResource) Create(ctx context.Context, req resource.CreateRequest,
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIDIdentity(ctx, resp.Identity, data.ID)...)
 }
 
 func (r *GroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -224,52 +237,58 @@ func (r *GroupResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
-	// Fetch data from API
-	reqURL := fmt.Sprintf("%s/api/groups/%s", r.client.BaseUrl, data.ID.ValueString())
-	httpReq, err := http.NewRequest("GET", reqURL, nil)
-	if err != nil {
-		resp.Diagnostics.AddError("Error creating request", err.Error())
+	// The framework requires an identity even when the object has been deleted
+	resp.Diagnostics.Append(setIDIdentity(ctx, resp.Identity, data.ID)...)
+
+	readTimeout, diags := data.Timeouts.Read(ctx, 0)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
+	ctx, cancel := timeoutContext(ctx, readTimeout)
+	defer cancel()
 
-	responseBody, err := r.client.doRequest(httpReq)
+	// Fetch data from API
+	responseData, err := getJSON[netbirdApi.Group](ctx, r.client, fmt.Sprintf("/api/groups/%s", data.ID.ValueString()))
 	if err != nil {
 		resp.Diagnostics.AddError("Error fetching group", err.Error())
 		return
 	}
 
-	// Handle when resource does not exist
-	if responseBody == nil {
-		data.ID = types.StringNull()
-		return
-	}
-
-	var responseData netbirdApi.Group
-	if err := json.Unmarshal(responseBody, &responseData); err != nil {
-		resp.Diagnostics.AddError("Error parsing response", err.Error())
+	// The object has been deleted outside of Terraform
+	if responseData == nil {
+		resp.State.RemoveResource(ctx)
 		return
 	}
 
 	// Update state with latest data
-	data.Name = types.StringValue(responseData.Name)
-	data.PeersCount = types.Int64Value(int64(responseData.PeersCount))
-	data.ResourcesCount = types.Int64Value(int64(responseData.ResourcesCount))
-	if responseData.Issued != nil {
-		data.Issued = types.StringValue(string(*responseData.Issued))
+	resp.Diagnostics.Append(groupIntoModel(ctx, *responseData, &data)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// groupIntoModel sets the attributes of data read from the API to those of group.
+func groupIntoModel(ctx context.Context, group netbirdApi.Group, data *GroupResourceModel) diag.Diagnostics {
+	data.ID = types.StringValue(group.Id)
+	data.Name = types.StringValue(group.Name)
+	data.PeersCount = types.Int64Value(int64(group.PeersCount))
+	data.ResourcesCount = types.Int64Value(int64(group.ResourcesCount))
+	if group.Issued != nil {
+		data.Issued = types.StringValue(string(*group.Issued))
 	}
 
 	// Convert peers list
 	var peersList []string
-	for _, peer := range responseData.Peers {
+	for _, peer := range group.Peers {
 		peersList = append(peersList, peer.Id)
 	}
 	var diags diag.Diagnostics
 	data.Peers, diags = types.ListValueFrom(ctx, types.StringType, peersList)
-	resp.Diagnostics.Append(diags...)
 
 	// Convert resources list
 	var resourcesList []GroupResourceResourceModel
-	for _, res := range responseData.Resources {
+	for _, res := range group.Resources {
 		resourcesList = append(resourcesList, GroupResourceResourceModel{
 			ID:   types.StringValue(res.Id),
 			Type: types.StringValue(string(res.Type)),
@@ -277,8 +296,7 @@ func (r *GroupResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	}
 	data.Resources = resourcesList
 
-	// Save updated data into Terraform state
-	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	return diags
 }
 
 func (r *GroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -291,6 +309,14 @@ func (r *GroupResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
+	updateTimeout, diags := data.Timeouts.Update(ctx, 0)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := timeoutContext(ctx, updateTimeout)
+	defer cancel()
+
 	// Convert Terraform list of peers to a Go slice
 	var peersList []string
 	resp.Diagnostics.Append(data.Peers.ElementsAs(ctx, &peersList, false)...)
@@ -307,37 +333,22 @@ func (r *GroupResource) Update(ctx context.Context, req resource.UpdateRequest,
 		})
 	}
 
-	// Prepare request body
-	requestBody, err := json.Marshal(netbirdApi.GroupRequest{
+	// API request
+	responseData, err := putJSON[netbirdApi.Group](ctx, r.client, fmt.Sprintf("/api/groups/%s", data.ID.ValueString()), netbirdApi.GroupRequest{
 		Name:      data.Name.ValueString(),
 		Peers:     &peersList,
 		Resources: &resourcesList,
 	})
-	if err != nil {
-		resp.Diagnostics.AddError("Error marshaling request body", err.Error())
-		return
-	}
-
-	// API request
-	reqURL := fmt.Sprintf("%s/api/groups/%s", r.client.BaseUrl, data.ID.ValueString())
-	httpReq, err := http.NewRequest("PUT", reqURL, bytes.NewBuffer(requestBody))
-	if err != nil {
-		resp.Diagnostics.AddError("Error creating request", err.Error())
-		return
-	}
-	httpReq.Header.Set("Content-Type", "application/json")
-
-	responseBody, err := r.client.doRequest(httpReq)
 	if err != nil {
 		resp.Diagnostics.AddError("Error updating group", err.Error())
 		return
 	}
-
-	// Parse response
-	var responseData netbirdApi.Group
-	if err := json.Unmarshal(responseBody, &responseData); err != nil {
-		resp.Diagnostics.AddError("Error parsing response", err.Error())
-		return
+	if responseData == nil {
+		responseData, err = requireJSON(getJSON[netbirdApi.Group](ctx, r.client, fmt.Sprintf("/api/groups/%s", data.ID.ValueString())))
+		if err != nil {
+			resp.Diagnostics.AddError("Error fetching group", err.Error())
+			return
+		}
 	}
 
 	// Set state values
@@ -353,7 +364,6 @@ func (r *GroupResource) Update(ctx context.Context, req resource.UpdateRequest,
 	for _, peer := range responseData.Peers {
 		updatedPeersList = append(updatedPeersList, peer.Id)
 	}
-	var diags diag.Diagnostics
 	data.Peers, diags = types.ListValueFrom(ctx, types.StringType, updatedPeersList)
 	resp.Diagnostics.Append(diags...)
 
@@ -368,6 +378,7 @@ func (r *GroupResource) Update(ctx context.Context, req resource.UpdateRequest,
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIDIdentity(ctx, resp.Identity, data.ID)...)
 }
 
 func (r *GroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -380,22 +391,71 @@ func (r *GroupResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
-	reqURL := fmt.Sprintf("%s/api/groups/%s", r.client.BaseUrl, data.ID.ValueString())
-	httpReq, err := http.NewRequest("DELETE", reqURL, nil)
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, 0)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := timeoutContext(ctx, deleteTimeout)
+	defer cancel()
+
+	// Deleting a group still used by a policy fails with an unhelpful API error
+	policyNames, err := r.policiesReferencingGroup(ctx, data.ID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Error creating request", err.Error())
+		resp.Diagnostics.AddError("Error fetching policies", err.Error())
+		return
+	}
+	if len(policyNames) > 0 {
+		resp.Diagnostics.AddError(
+			"Group is in use",
+			fmt.Sprintf("Group %q is referenced by the sources or destinations of the following policies: %s. "+
+				"Remove the group from these policies before deleting it.",
+				data.Name.ValueString(), strings.Join(policyNames, ", ")),
+		)
 		return
 	}
 
-	_, err = r.client.doRequest(httpReq)
+	err = deleteObject(ctx, r.client, fmt.Sprintf("/api/groups/%s", data.ID.ValueString()))
 	if err != nil {
-		resp.Diagnostics.AddError("Error deleting network", err.Error())
+		resp.Diagnostics.AddError("Error deleting group", err.Error())
 		return
 	}
 
 	resp.State.RemoveResource(ctx)
 }
 
+// policiesReferencingGroup returns the names of policies with a rule using the
+// group as a source or destination.
+func (r *GroupResource) policiesReferencingGroup(ctx context.Context, groupID string) ([]string, error) {
+	policies, err := getJSON[[]netbirdApi.Policy](ctx, r.client, "/api/policies")
+	if err != nil || policies == nil {
+		return nil, err
+	}
+
+	var names []string
+	for _, policy := range *policies {
+		for _, rule := range policy.Rules {
+			if groupMinimumsContain(rule.Sources, groupID) || groupMinimumsContain(rule.Destinations, groupID) {
+				names = append(names, policy.Name)
+				break
+			}
+		}
+	}
+	return names, nil
+}
+
+func groupMinimumsContain(groups *[]netbirdApi.GroupMinimum, groupID string) bool {
+	if groups == nil {
+		return false
+	}
+	for _, group := range *groups {
+		if group.Id == groupID {
+			return true
+		}
+	}
+	return false
+}
+
 func (r *GroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
 }
@@ -0,0 +1,171 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	netbirdApi "github.com/netbirdio/netbird/management/server/http/api"
+)
+
+func TestAccGroupResource(t *testing.T) {
+	providerConfig, mock := testAccProviderConfig(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckDestroy(mock, "netbird_group", staticPath("/api/groups")),
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + testAccGroupResourceConfig("tf-acc-group"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("netbird_group.test", "name", "tf-acc-group"),
+					resource.TestCheckResourceAttr("netbird_group.test", "peers_count", "0"),
+					resource.TestCheckResourceAttr("netbird_group.test", "issued", "api"),
+					resource.TestCheckResourceAttrSet("netbird_group.test", "id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "netbird_group.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + testAccGroupResourceConfig("tf-acc-group-renamed"),
+				// issued is not changed by updates, and the counts only change
+				// with the members, so they stay known in the plan
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectKnownValue("netbird_group.test", tfjsonpath.New("issued"), knownvalue.StringExact("api")),
+						plancheck.ExpectKnownValue("netbird_group.test", tfjsonpath.New("peers_count"), knownvalue.Int64Exact(0)),
+						plancheck.ExpectKnownValue("netbird_group.test", tfjsonpath.New("resources_count"), knownvalue.Int64Exact(0)),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("netbird_group.test", "name", "tf-acc-group-renamed"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccGroupResourceConfig(name string) string {
+	return fmt.Sprintf(`
+resource "netbird_group" "test" {
+  name = %q
+}
+`, name)
+}
+
+func TestAccGroupResource_counts(t *testing.T) {
+	testAccMockOnly(t)
+	providerConfig, mock := testAccProviderConfig(t)
+	peerID := mock.Seed("/api/peers", netbirdApi.PeerBatch{Name: "tf-acc-peer"})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckDestroy(mock, "netbird_group", staticPath("/api/groups")),
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + testAccGroupResourcePeersConfig("tf-acc-counts", ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("netbird_group.test", "peers_count", "0"),
+				),
+			},
+			// Changing the members leaves the counts unknown until applied
+			{
+				Config: providerConfig + testAccGroupResourcePeersConfig("tf-acc-counts", peerID),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectUnknownValue("netbird_group.test", tfjsonpath.New("peers_count")),
+						plancheck.ExpectUnknownValue("netbird_group.test", tfjsonpath.New("resources_count")),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("netbird_group.test", "peers_count", "1"),
+				),
+			},
+			{
+				Config: providerConfig + testAccGroupResourcePeersConfig("tf-acc-counts-renamed", peerID),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectKnownValue("netbird_group.test", tfjsonpath.New("peers_count"), knownvalue.Int64Exact(1)),
+					},
+				},
+			},
+		},
+	})
+}
+
+func testAccGroupResourcePeersConfig(name string, peerID string) string {
+	if peerID == "" {
+		return testAccGroupResourceConfig(name)
+	}
+	return fmt.Sprintf(`
+resource "netbird_group" "test" {
+  name  = %q
+  peers = [%q]
+}
+`, name, peerID)
+}
+
+func TestAccGroupResource_disappears(t *testing.T) {
+	testAccMockOnly(t)
+	providerConfig, mock := testAccProviderConfig(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + testAccGroupResourceConfig("tf-acc-disappears"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDisappears(mock, "netbird_group.test", staticPath("/api/groups")),
+				),
+				// The deleted object is removed from state and planned for creation
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("netbird_group.test", plancheck.ResourceActionCreate),
+					},
+				},
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccGroupResource_identity(t *testing.T) {
+	providerConfig, mock := testAccProviderConfig(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_12_0),
+		},
+		CheckDestroy: testAccCheckDestroy(mock, "netbird_group", staticPath("/api/groups")),
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + testAccGroupResourceConfig("tf-acc-identity"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectIdentityValueMatchesState("netbird_group.test", tfjsonpath.New("id")),
+				},
+			},
+			{
+				ResourceName:    "netbird_group.test",
+				ImportState:     true,
+				ImportStateKind: resource.ImportBlockWithResourceIdentity,
+			},
+		},
+	})
+}
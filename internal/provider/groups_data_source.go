@@ -0,0 +1,114 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &GroupsDataSource{}
+
+func NewGroupsDataSource() datasource.DataSource {
+	return &GroupsDataSource{}
+}
+
+// GroupsDataSource lists the groups of the account.
+type GroupsDataSource struct {
+	client ClientInterface
+}
+
+func (d *GroupsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_groups"
+}
+
+func (d *GroupsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	groupAttributes := groupDataSourceAttributes()
+	groupAttributes["id"] = schema.StringAttribute{
+		Computed:    true,
+		Description: "Unique identifier of the group.",
+	}
+
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Retrieve the groups of the account, optionally filtered by name and how they were issued. " +
+			"Groups must match all given filters.",
+
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only return groups with this name.",
+			},
+			"issued": schema.StringAttribute{
+				Optional: true,
+				MarkdownDescription: "Only return groups issued this way: `api` for groups created in the dashboard or API, " +
+					"`jwt` for groups synced from JWT claims or `integration` for groups synced from an identity provider.",
+				Validators: []validator.String{
+					groupIssuedValidator,
+				},
+			},
+			"groups": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Groups matching the filters.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: groupAttributes,
+				},
+			},
+		},
+	}
+}
+
+func (d *GroupsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(ClientInterface)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *GroupsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data GroupsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	groups, err := listGroups(ctx, d.client)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Making API Request", err.Error())
+		return
+	}
+
+	data.Groups = []GroupDataSourceModel{}
+	for _, group := range groups {
+		// The API can't filter groups, so filter client-side
+		if !data.Name.IsNull() && group.Name != data.Name.ValueString() {
+			continue
+		}
+		if !data.Issued.IsNull() && (group.Issued == nil || string(*group.Issued) != data.Issued.ValueString()) {
+			continue
+		}
+		data.Groups = append(data.Groups, convertGroupToDataSourceModel(group))
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,85 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	netbirdApi "github.com/netbirdio/netbird/management/server/http/api"
+)
+
+func TestAccGroupsDataSource(t *testing.T) {
+	testAccMockOnly(t)
+	providerConfig, mock := testAccProviderConfig(t)
+	peerID := mock.Seed("/api/peers", netbirdApi.PeerBatch{Name: "tf-acc-peer"})
+	apiGroupID := mock.Seed("/api/groups", map[string]any{"name": "tf-acc-dashboard", "issued": "api", "peers": []string{peerID}})
+	mock.Seed("/api/groups", map[string]any{"name": "tf-acc-engineering", "issued": "jwt"})
+	integrationGroupID := mock.Seed("/api/groups", map[string]any{"name": "tf-acc-engineering", "issued": "integration"})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+data "netbird_groups" "all" {}
+
+data "netbird_groups" "api" {
+  issued = "api"
+}
+
+data "netbird_groups" "engineering" {
+  name = "tf-acc-engineering"
+}
+
+# Filters are combined
+data "netbird_groups" "engineering_integration" {
+  name   = "tf-acc-engineering"
+  issued = "integration"
+}
+
+data "netbird_groups" "none" {
+  name   = "tf-acc-dashboard"
+  issued = "jwt"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.netbird_groups.all", "groups.#", "3"),
+					resource.TestCheckResourceAttr("data.netbird_groups.api", "groups.#", "1"),
+					resource.TestCheckResourceAttr("data.netbird_groups.api", "groups.0.id", apiGroupID),
+					resource.TestCheckResourceAttr("data.netbird_groups.api", "groups.0.name", "tf-acc-dashboard"),
+					resource.TestCheckResourceAttr("data.netbird_groups.api", "groups.0.issued", "api"),
+					resource.TestCheckResourceAttr("data.netbird_groups.api", "groups.0.peers.#", "1"),
+					resource.TestCheckResourceAttr("data.netbird_groups.api", "groups.0.peers.0.id", peerID),
+					resource.TestCheckResourceAttr("data.netbird_groups.api", "groups.0.peers.0.name", "tf-acc-peer"),
+					resource.TestCheckResourceAttr("data.netbird_groups.api", "groups.0.peer_ids.0", peerID),
+					resource.TestCheckResourceAttr("data.netbird_groups.api", "groups.0.peers_count", "1"),
+					resource.TestCheckResourceAttr("data.netbird_groups.api", "groups.0.resources_count", "0"),
+					resource.TestCheckResourceAttr("data.netbird_groups.engineering", "groups.#", "2"),
+					resource.TestCheckResourceAttr("data.netbird_groups.engineering_integration", "groups.#", "1"),
+					resource.TestCheckResourceAttr("data.netbird_groups.engineering_integration", "groups.0.id", integrationGroupID),
+					resource.TestCheckResourceAttr("data.netbird_groups.none", "groups.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccGroupsDataSource_invalidIssued(t *testing.T) {
+	providerConfig, _ := testAccProviderConfig(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+data "netbird_groups" "test" {
+  issued = "manual"
+}
+`,
+				ExpectError: regexp.MustCompile("must be one of api, jwt or integration"),
+			},
+		},
+	})
+}
@@ -1,8 +1,17 @@
 package provider
 
 import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	netbirdApi "github.com/netbirdio/netbird/management/server/http/api"
 )
@@ -38,6 +47,139 @@ func convertStrings(input []string) []types.String {
 	return output
 }
 
+// peerRegistration holds the registration date of a peer, which newer
+// management servers return as `created_at` but is missing from the API types.
+type peerRegistration struct {
+	CreatedAt *time.Time `json:"created_at"`
+}
+
+// peerWithRegistration is a peer as returned by the API, including its registration date.
+type peerWithRegistration struct {
+	netbirdApi.PeerBatch
+	peerRegistration
+}
+
+func (p peerRegistration) registeredAt() types.String {
+	if p.CreatedAt == nil {
+		return types.StringNull()
+	}
+	return formatTimestamp(*p.CreatedAt)
+}
+
+// peerIssuedByDescription describes the issued_by attribute of peers.
+const peerIssuedByDescription = "How the peer was added: `user` when a user logged in with it, e.g. through SSO, " +
+	"or `setup_key` when it was registered with a setup key. Derived from `user_id`, which the API only sets for peers added by a user."
+
+// peerIssuedBy returns how a peer was added from the user_id of the peer. The
+// API does not report it, but only sets the user of peers added by a user
+// logging in.
+func peerIssuedBy(userID string) types.String {
+	if userID == "" {
+		return types.StringValue("setup_key")
+	}
+	return types.StringValue("user")
+}
+
+// formatTimestamp formats t in RFC 3339 format in UTC, or returns null for the
+// zero time the API returns for events that never happened.
+func formatTimestamp(t time.Time) types.String {
+	if t.IsZero() {
+		return types.StringNull()
+	}
+	return types.StringValue(t.UTC().Format(time.RFC3339))
+}
+
+// unixTimestamp returns t as seconds since the Unix epoch, or null for the zero time.
+func unixTimestamp(t time.Time) types.Int64 {
+	if t.IsZero() {
+		return types.Int64Null()
+	}
+	return types.Int64Value(t.Unix())
+}
+
+// groupLister is implemented by clients with their own group lookup, see
+// Client.GetGroups.
+type groupLister interface {
+	GetGroups(ctx context.Context) ([]netbirdApi.Group, error)
+}
+
+// listGroups returns every group in the account.
+func listGroups(ctx context.Context, client ClientInterface) ([]netbirdApi.Group, error) {
+	if lister, ok := client.(groupLister); ok {
+		return lister.GetGroups(ctx)
+	}
+	groups, err := getJSON[[]netbirdApi.Group](ctx, client, "/api/groups")
+	if err != nil || groups == nil {
+		return nil, err
+	}
+	return *groups, nil
+}
+
+// resolveGroupNames looks up the IDs of the named groups, failing if a name
+// matches no group or more than one.
+func resolveGroupNames(ctx context.Context, client ClientInterface, names []string) ([]string, error) {
+	groups, err := listGroups(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+
+	var ids, missing []string
+	for _, name := range names {
+		group, err := findGroupByName(groups, name)
+		if err != nil {
+			return nil, err
+		}
+		if group == nil {
+			missing = append(missing, name)
+			continue
+		}
+		ids = append(ids, group.Id)
+	}
+	if len(missing) > 0 {
+		return nil, fmt.Errorf("no group found with name: %s", strings.Join(missing, ", "))
+	}
+	return ids, nil
+}
+
+// findGroupByName returns the group with the given name, or nil if there is
+// none, failing if the name matches more than one group.
+func findGroupByName(groups []netbirdApi.Group, name string) (*netbirdApi.Group, error) {
+	var found *netbirdApi.Group
+	for i := range groups {
+		if groups[i].Name != name {
+			continue
+		}
+		if found != nil {
+			return nil, fmt.Errorf("group name %q is ambiguous, it matches groups %s, %s", name, found.Id, groups[i].Id)
+		}
+		found = &groups[i]
+	}
+	return found, nil
+}
+
+// timeoutContext returns a context for an operation limited to timeout, or
+// only bounded by the client request timeout when timeout is zero.
+func timeoutContext(ctx context.Context, timeout time.Duration) (context.Context, context.CancelFunc) {
+	if timeout <= 0 {
+		return context.WithCancel(ctx)
+	}
+	return context.WithTimeout(ctx, timeout)
+}
+
+// parseNetworkChildImportID splits the "<network_id>/<id>" import ID of
+// routers and resources, which are only addressable within their network.
+func parseNetworkChildImportID(importID string) (string, string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	networkID, id, ok := strings.Cut(importID, "/")
+	if !ok || networkID == "" || id == "" || strings.Contains(id, "/") {
+		diags.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: <network_id>/<id>. Got: %q", importID),
+		)
+	}
+	return networkID, id, diags
+}
+
 func derefString(input *string) types.String {
 	if input == nil {
 		return types.StringNull()
@@ -61,15 +203,17 @@ func stringSliceToTerraform(apiValues []string) []types.String {
 	return result
 }
 
+// convertStringSliceToListValue converts strings to a list. A nil slice, for
+// absent data, converts to a null list, an empty slice to an empty list.
 func convertStringSliceToListValue(strings []string) (types.List, diag.Diagnostics) {
-	var stringValueList []attr.Value
-	var diags diag.Diagnostics
+	if strings == nil {
+		return types.ListNull(types.StringType), nil
+	}
+
+	stringValueList := []attr.Value{}
 	for _, val := range strings {
 		stringValueList = append(stringValueList, types.StringValue(val))
 	}
-	if len(stringValueList) == 0 {
-		return types.ListNull(types.StringType), diags
-	}
 
 	listValue, diags := types.ListValue(types.StringType, stringValueList)
 	if diags.HasError() {
@@ -78,13 +222,51 @@ func convertStringSliceToListValue(strings []string) (types.List, diag.Diagnosti
 	return listValue, diags
 }
 
+// preserveNullList returns a null string list instead of value when value is
+// empty and prior, the planned or previous value, is null. The API returns
+// empty lists for optional attributes which are not set.
+func preserveNullList(value types.List, prior types.List) types.List {
+	if prior.IsNull() && !value.IsUnknown() && len(value.Elements()) == 0 {
+		return types.ListNull(types.StringType)
+	}
+	return value
+}
+
+// knownStrings returns the string elements of a list or set which are known,
+// skipping null and unknown values.
+func knownStrings(elements []attr.Value) []string {
+	var result []string
+	for _, element := range elements {
+		if str, ok := element.(types.String); ok && !str.IsNull() && !str.IsUnknown() {
+			result = append(result, str.ValueString())
+		}
+	}
+	return result
+}
+
+// listToSetValue converts a list from a prior schema version to a set for
+// state upgraders. Duplicate elements, which a list allows, are dropped.
+func listToSetValue(ctx context.Context, list types.List) (types.Set, diag.Diagnostics) {
+	if list.IsNull() {
+		return types.SetNull(list.ElementType(ctx)), nil
+	}
+
+	elements := []attr.Value{}
+	for _, element := range list.Elements() {
+		if !slices.ContainsFunc(elements, element.Equal) {
+			elements = append(elements, element)
+		}
+	}
+	return types.SetValue(list.ElementType(ctx), elements)
+}
+
 func convertGroupMinimumToIdList(groupList *[]netbirdApi.GroupMinimum) (types.List, diag.Diagnostics) {
 	var diags diag.Diagnostics
-	var idList []string
 	if groupList == nil {
 		return types.ListNull(types.StringType), diags
 	}
 
+	idList := []string{}
 	for _, group := range *groupList {
 		idList = append(idList, group.Id)
 	}
@@ -98,3 +280,76 @@ func nullStringToEmptyString(input types.String) types.String {
 	}
 	return input
 }
+
+// int64UseStateUnlessChangedModifier keeps the prior state value of a
+// computed attribute in the plan unless one of the attributes it is derived
+// from changes, in which case the value is left unknown.
+type int64UseStateUnlessChangedModifier struct {
+	paths []path.Path
+}
+
+func int64UseStateUnlessChanged(paths ...path.Path) planmodifier.Int64 {
+	return int64UseStateUnlessChangedModifier{paths: paths}
+}
+
+func (m int64UseStateUnlessChangedModifier) Description(ctx context.Context) string {
+	return "Once set, the value of this attribute in state will not change unless the attributes it is derived from change."
+}
+
+func (m int64UseStateUnlessChangedModifier) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m int64UseStateUnlessChangedModifier) PlanModifyInt64(ctx context.Context, req planmodifier.Int64Request, resp *planmodifier.Int64Response) {
+	// Nothing to keep on create, or when the value is configured
+	if req.StateValue.IsNull() || !req.PlanValue.IsUnknown() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if unchanged(ctx, req.Plan, req.State, m.paths, &resp.Diagnostics) {
+		resp.PlanValue = req.StateValue
+	}
+}
+
+// stringUseStateUnlessChangedModifier is the string counterpart of
+// int64UseStateUnlessChangedModifier.
+type stringUseStateUnlessChangedModifier struct {
+	paths []path.Path
+}
+
+func stringUseStateUnlessChanged(paths ...path.Path) planmodifier.String {
+	return stringUseStateUnlessChangedModifier{paths: paths}
+}
+
+func (m stringUseStateUnlessChangedModifier) Description(ctx context.Context) string {
+	return "Once set, the value of this attribute in state will not change unless the attributes it is derived from change."
+}
+
+func (m stringUseStateUnlessChangedModifier) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m stringUseStateUnlessChangedModifier) PlanModifyString(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	// Nothing to keep on create, or when the value is configured
+	if req.StateValue.IsNull() || !req.PlanValue.IsUnknown() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if unchanged(ctx, req.Plan, req.State, m.paths, &resp.Diagnostics) {
+		resp.PlanValue = req.StateValue
+	}
+}
+
+// unchanged reports whether the attributes at paths are planned with their
+// prior state values.
+func unchanged(ctx context.Context, plan tfsdk.Plan, state tfsdk.State, paths []path.Path, diags *diag.Diagnostics) bool {
+	for _, attributePath := range paths {
+		var planned, prior attr.Value
+		diags.Append(plan.GetAttribute(ctx, attributePath, &planned)...)
+		diags.Append(state.GetAttribute(ctx, attributePath, &prior)...)
+		if diags.HasError() || !planned.Equal(prior) {
+			return false
+		}
+	}
+	return true
+}
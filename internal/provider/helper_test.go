@@ -0,0 +1,96 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	netbirdApi "github.com/netbirdio/netbird/management/server/http/api"
+)
+
+func TestConvertStringSliceToListValue(t *testing.T) {
+	for name, tc := range map[string]struct {
+		input []string
+		want  types.List
+	}{
+		"nil": {
+			input: nil,
+			want:  types.ListNull(types.StringType),
+		},
+		"empty": {
+			input: []string{},
+			want:  types.ListValueMust(types.StringType, []attr.Value{}),
+		},
+		"values": {
+			input: []string{"a", "b"},
+			want:  types.ListValueMust(types.StringType, []attr.Value{types.StringValue("a"), types.StringValue("b")}),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			got, diags := convertStringSliceToListValue(tc.input)
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			if !got.Equal(tc.want) {
+				t.Errorf("got %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestConvertGroupMinimumToIdList(t *testing.T) {
+	for name, tc := range map[string]struct {
+		input *[]netbirdApi.GroupMinimum
+		want  types.List
+	}{
+		"nil": {
+			input: nil,
+			want:  types.ListNull(types.StringType),
+		},
+		"empty": {
+			input: &[]netbirdApi.GroupMinimum{},
+			want:  types.ListValueMust(types.StringType, []attr.Value{}),
+		},
+		"groups": {
+			input: &[]netbirdApi.GroupMinimum{{Id: "g1"}, {Id: "g2"}},
+			want:  types.ListValueMust(types.StringType, []attr.Value{types.StringValue("g1"), types.StringValue("g2")}),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			got, diags := convertGroupMinimumToIdList(tc.input)
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			if !got.Equal(tc.want) {
+				t.Errorf("got %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestPreserveNullList(t *testing.T) {
+	null := types.ListNull(types.StringType)
+	empty := types.ListValueMust(types.StringType, []attr.Value{})
+	values := types.ListValueMust(types.StringType, []attr.Value{types.StringValue("a")})
+
+	for name, tc := range map[string]struct {
+		value types.List
+		prior types.List
+		want  types.List
+	}{
+		"empty with null prior":    {value: empty, prior: null, want: null},
+		"empty with empty prior":   {value: empty, prior: empty, want: empty},
+		"empty with values prior":  {value: empty, prior: values, want: empty},
+		"values with null prior":   {value: values, prior: null, want: values},
+		"null with empty prior":    {value: null, prior: empty, want: null},
+		"empty with unknown prior": {value: empty, prior: types.ListUnknown(types.StringType), want: empty},
+		"unknown with null prior":  {value: types.ListUnknown(types.StringType), prior: null, want: types.ListUnknown(types.StringType)},
+		"values with values prior": {value: values, prior: values, want: values},
+	} {
+		t.Run(name, func(t *testing.T) {
+			if got := preserveNullList(tc.value, tc.prior); !got.Equal(tc.want) {
+				t.Errorf("got %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,87 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// idIdentityModel is the identity of objects addressed by their ID alone.
+type idIdentityModel struct {
+	ID types.String `tfsdk:"id"`
+}
+
+// networkChildIdentityModel is the identity of routers and resources, which
+// are only addressable within their network.
+type networkChildIdentityModel struct {
+	NetworkID types.String `tfsdk:"network_id"`
+	ID        types.String `tfsdk:"id"`
+}
+
+func idIdentitySchema(object string) identityschema.Schema {
+	return identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       fmt.Sprintf("Unique identifier of the %s.", object),
+			},
+		},
+	}
+}
+
+func networkChildIdentitySchema(object string) identityschema.Schema {
+	return identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"network_id": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       fmt.Sprintf("Unique identifier of the network the %s belongs to.", object),
+			},
+			"id": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       fmt.Sprintf("Unique identifier of the %s.", object),
+			},
+		},
+	}
+}
+
+// setIDIdentity stores the identity of an object addressed by its ID. The
+// identity is nil when the resource is called outside of a Terraform run.
+func setIDIdentity(ctx context.Context, identity *tfsdk.ResourceIdentity, id types.String) diag.Diagnostics {
+	if identity == nil {
+		return nil
+	}
+	return identity.Set(ctx, idIdentityModel{ID: id})
+}
+
+// setNetworkChildIdentity stores the identity of a router or resource.
+func setNetworkChildIdentity(ctx context.Context, identity *tfsdk.ResourceIdentity, networkID, id types.String) diag.Diagnostics {
+	if identity == nil {
+		return nil
+	}
+	return identity.Set(ctx, networkChildIdentityModel{NetworkID: networkID, ID: id})
+}
+
+// importNetworkChildState imports a router or resource either from its
+// "<network_id>/<id>" import ID or from its identity.
+func importNetworkChildState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	var identity networkChildIdentityModel
+	if req.ID != "" {
+		networkID, id, diags := parseNetworkChildImportID(req.ID)
+		resp.Diagnostics.Append(diags...)
+		identity = networkChildIdentityModel{NetworkID: types.StringValue(networkID), ID: types.StringValue(id)}
+	} else {
+		resp.Diagnostics.Append(req.Identity.Get(ctx, &identity)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("network_id"), identity.NetworkID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), identity.ID)...)
+}
@@ -0,0 +1,260 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	netbirdApi "github.com/netbirdio/netbird/management/server/http/api"
+)
+
+// MockRequest is a request recorded by MockClient.
+type MockRequest struct {
+	Method string
+	Path   string
+	Body   []byte
+}
+
+// MockClient is a ClientInterface serving canned responses, keyed by
+// "METHOD path", without making any HTTP calls. Requests without a canned
+// response return no body, the same as a not-found object.
+type MockClient struct {
+	Responses map[string]string
+	Errors    map[string]error
+	Requests  []MockRequest
+}
+
+// Ensure MockClient satisfies ClientInterface.
+var _ ClientInterface = &MockClient{}
+
+func (m *MockClient) respond(method string, path string, body []byte) ([]byte, error) {
+	m.Requests = append(m.Requests, MockRequest{Method: method, Path: path, Body: body})
+	key := method + " " + path
+	if err, ok := m.Errors[key]; ok {
+		return nil, err
+	}
+	if response, ok := m.Responses[key]; ok {
+		return []byte(response), nil
+	}
+	return nil, nil
+}
+
+func (m *MockClient) DoGet(ctx context.Context, path string) ([]byte, error) {
+	return m.respond("GET", path, nil)
+}
+
+func (m *MockClient) DoPost(ctx context.Context, path string, body []byte) ([]byte, error) {
+	return m.respond("POST", path, body)
+}
+
+func (m *MockClient) DoPut(ctx context.Context, path string, body []byte) ([]byte, error) {
+	return m.respond("PUT", path, body)
+}
+
+func (m *MockClient) DoDelete(ctx context.Context, path string) error {
+	_, err := m.respond("DELETE", path, nil)
+	return err
+}
+
+func TestResourceConfigureAcceptsMockClient(t *testing.T) {
+	r := &GroupResource{}
+	client := &MockClient{}
+	resp := &resource.ConfigureResponse{}
+
+	r.Configure(context.Background(), resource.ConfigureRequest{ProviderData: client}, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+	if r.client != client {
+		t.Fatal("mock client was not configured on the resource")
+	}
+}
+
+func TestNetworkResourceReadIntoModel(t *testing.T) {
+	client := &MockClient{Responses: map[string]string{
+		"GET /api/networks/net1": `{"id":"net1","name":"core","description":"Core network","routers":["r1"],"resources":["res1","res2"],"policies":[],"routing_peers_count":2}`,
+	}}
+	r := &NetworkResource{client: client}
+	data := NetworkResourceModel{ID: types.StringValue("net1")}
+
+	diags := r.readIntoModel(context.Background(), &data)
+
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if data.Name.ValueString() != "core" || data.Description.ValueString() != "Core network" {
+		t.Errorf("unexpected name/description: %s/%s", data.Name, data.Description)
+	}
+	if data.RoutingPeersCount.ValueInt64() != 2 {
+		t.Errorf("unexpected routing peers count: %s", data.RoutingPeersCount)
+	}
+	if len(data.Resources.Elements()) != 2 || len(data.Routers.Elements()) != 1 {
+		t.Errorf("unexpected routers/resources: %s/%s", data.Routers, data.Resources)
+	}
+}
+
+func TestNetworkResourceReadIntoModelNotFound(t *testing.T) {
+	r := &NetworkResource{client: &MockClient{}}
+	data := NetworkResourceModel{ID: types.StringValue("missing")}
+
+	diags := r.readIntoModel(context.Background(), &data)
+
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if !data.ID.IsNull() {
+		t.Errorf("expected ID to be cleared for missing network, got %s", data.ID)
+	}
+}
+
+func TestNetworkResourceReadIntoModelError(t *testing.T) {
+	client := &MockClient{Errors: map[string]error{
+		"GET /api/networks/net1": fmt.Errorf("boom"),
+	}}
+	r := &NetworkResource{client: client}
+	data := NetworkResourceModel{ID: types.StringValue("net1")}
+
+	diags := r.readIntoModel(context.Background(), &data)
+
+	if !diags.HasError() {
+		t.Fatal("expected API error to be surfaced as a diagnostic")
+	}
+}
+
+func TestDnsSettingsUpdateSendsGroups(t *testing.T) {
+	client := &MockClient{Responses: map[string]string{
+		"PUT /api/dns/settings": `{"disabled_management_groups":["g1"]}`,
+	}}
+	r := &DnsSettingsResource{client: client}
+	groups, _ := types.ListValueFrom(context.Background(), types.StringType, []string{"g1"})
+
+	_, diags := r.updateDnsSettings(context.Background(), &DnsSettingsResourceModel{DisabledManagementGroups: groups})
+
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if len(client.Requests) != 1 {
+		t.Fatalf("expected a single request, got %d", len(client.Requests))
+	}
+	var sent map[string][]string
+	if err := json.Unmarshal(client.Requests[0].Body, &sent); err != nil {
+		t.Fatal(err)
+	}
+	if len(sent["disabled_management_groups"]) != 1 || sent["disabled_management_groups"][0] != "g1" {
+		t.Errorf("unexpected request body: %s", client.Requests[0].Body)
+	}
+}
+
+func TestGroupResourcePoliciesReferencingGroup(t *testing.T) {
+	client := &MockClient{Responses: map[string]string{
+		"GET /api/policies": `[
+			{"id":"p1","name":"ssh","rules":[{"name":"r1","sources":[{"id":"g1","name":"admins"}],"destinations":[{"id":"g2","name":"servers"}]}]},
+			{"id":"p2","name":"web","rules":[{"name":"r1","sources":[{"id":"g3","name":"users"}],"destinations":[{"id":"g1","name":"admins"}]}]},
+			{"id":"p3","name":"dns","rules":[{"name":"r1","sources":[{"id":"g3","name":"users"}]}]}
+		]`,
+	}}
+	r := &GroupResource{client: client}
+
+	names, err := r.policiesReferencingGroup(context.Background(), "g1")
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(names) != 2 || names[0] != "ssh" || names[1] != "web" {
+		t.Errorf("expected policies ssh and web, got %v", names)
+	}
+
+	names, err = r.policiesReferencingGroup(context.Background(), "unused")
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(names) != 0 {
+		t.Errorf("expected no policies, got %v", names)
+	}
+}
+
+func TestResolveGroupNames(t *testing.T) {
+	client := &MockClient{Responses: map[string]string{
+		"GET /api/groups": `[{"id":"g1","name":"admins"},{"id":"g2","name":"servers"},{"id":"g3","name":"dup"},{"id":"g4","name":"dup"}]`,
+	}}
+
+	ids, err := resolveGroupNames(context.Background(), client, []string{"servers", "admins"})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(ids) != 2 || ids[0] != "g2" || ids[1] != "g1" {
+		t.Errorf("expected IDs g2 and g1, got %v", ids)
+	}
+
+	if _, err := resolveGroupNames(context.Background(), client, []string{"admins", "missing"}); err == nil || !strings.Contains(err.Error(), "missing") {
+		t.Errorf("expected error for missing group, got %v", err)
+	}
+	if _, err := resolveGroupNames(context.Background(), client, []string{"dup"}); err == nil || !strings.Contains(err.Error(), "ambiguous") {
+		t.Errorf("expected error for ambiguous group, got %v", err)
+	}
+}
+
+func TestJSONHelpers(t *testing.T) {
+	client := &MockClient{
+		Responses: map[string]string{
+			"GET /api/groups/g1":  `{"id":"g1","name":"admins"}`,
+			"GET /api/groups/bad": `{"id":`,
+			"POST /api/groups":    `{"id":"g2","name":"servers"}`,
+		},
+		Errors: map[string]error{
+			"PUT /api/groups/g3": fmt.Errorf("boom"),
+		},
+	}
+	ctx := context.Background()
+
+	group, err := getJSON[netbirdApi.Group](ctx, client, "/api/groups/g1")
+	if err != nil || group == nil || group.Name != "admins" {
+		t.Errorf("unexpected result for existing group: %v %v", group, err)
+	}
+
+	group, err = getJSON[netbirdApi.Group](ctx, client, "/api/groups/missing")
+	if err != nil || group != nil {
+		t.Errorf("expected nil for missing group, got %v %v", group, err)
+	}
+
+	if _, err := getJSON[netbirdApi.Group](ctx, client, "/api/groups/bad"); err == nil || !strings.Contains(err.Error(), "error parsing response") {
+		t.Errorf("expected parse error, got %v", err)
+	}
+
+	group, err = postJSON[netbirdApi.Group](ctx, client, "/api/groups", netbirdApi.GroupRequest{Name: "servers"})
+	if err != nil || group == nil || group.Id != "g2" {
+		t.Errorf("unexpected result for created group: %v %v", group, err)
+	}
+	if sent := string(client.Requests[len(client.Requests)-1].Body); sent != `{"name":"servers"}` {
+		t.Errorf("unexpected request body: %s", sent)
+	}
+
+	if _, err := putJSON[netbirdApi.Group](ctx, client, "/api/groups/missing", netbirdApi.GroupRequest{Name: "x"}); err == nil {
+		t.Error("expected error for update without a response")
+	}
+	if _, err := putJSON[netbirdApi.Group](ctx, client, "/api/groups/g3", netbirdApi.GroupRequest{Name: "x"}); err == nil || err.Error() != "PUT /api/groups/g3: boom" {
+		t.Errorf("expected client error to be returned, got %v", err)
+	}
+}
+
+func TestDeleteObject(t *testing.T) {
+	client := &MockClient{Errors: map[string]error{
+		"DELETE /api/groups/gone":   &APIError{Method: "DELETE", Path: "/api/groups/gone", StatusCode: 404},
+		"DELETE /api/groups/forbid": &APIError{Method: "DELETE", Path: "/api/groups/forbid", StatusCode: 403},
+	}}
+	ctx := context.Background()
+
+	if err := deleteObject(ctx, client, "/api/groups/gone"); err != nil {
+		t.Errorf("expected an already deleted object to be ignored, got %v", err)
+	}
+	if err := deleteObject(ctx, client, "/api/groups/forbid"); !errors.Is(err, client.Errors["DELETE /api/groups/forbid"]) {
+		t.Errorf("expected other errors to be returned, got %v", err)
+	}
+}
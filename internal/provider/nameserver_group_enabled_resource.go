@@ -0,0 +1,212 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	netbirdApi "github.com/netbirdio/netbird/management/server/http/api"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &NameserverGroupEnabledResource{}
+var _ resource.ResourceWithImportState = &NameserverGroupEnabledResource{}
+
+func NewNameserverGroupEnabledResource() resource.Resource {
+	return &NameserverGroupEnabledResource{}
+}
+
+// NameserverGroupEnabledResource manages only the enabled flag of a nameserver group.
+type NameserverGroupEnabledResource struct {
+	client ClientInterface
+}
+
+type NameserverGroupEnabledResourceModel struct {
+	ID                types.String `tfsdk:"id"`
+	NameserverGroupID types.String `tfsdk:"nameserver_group_id"`
+	Enabled           types.Bool   `tfsdk:"enabled"`
+}
+
+func (r *NameserverGroupEnabledResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_nameserver_group_enabled"
+}
+
+func (r *NameserverGroupEnabledResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Enables or disables an existing nameserver group, e.g. during maintenance, leaving the rest of the group untouched. " +
+			"The API has no partial updates, so the current group is read and written back with only `enabled` changed. " +
+			"When the group is also managed by a `netbird_nameserver_group` resource, add `enabled` to its `ignore_changes`. " +
+			"Destroying the resource only removes it from the Terraform state.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Nameserver group ID",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"nameserver_group_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the nameserver group",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether the nameserver group is enabled",
+				Required:            true,
+			},
+		},
+	}
+}
+
+func (r *NameserverGroupEnabledResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(ClientInterface)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// getNameserverGroup returns the nameserver group, or nil if it does not exist.
+func (r *NameserverGroupEnabledResource) getNameserverGroup(ctx context.Context, id string) (*netbirdApi.NameserverGroup, diag.Diagnostics) {
+	diags := diag.Diagnostics{}
+	responseData, err := getJSON[netbirdApi.NameserverGroup](ctx, r.client, fmt.Sprintf("/api/dns/nameservers/%s", id))
+	if err != nil {
+		diags.AddError("Error fetching nameserver group", err.Error())
+	}
+	return responseData, diags
+}
+
+// setEnabled writes the nameserver group back with only the enabled flag changed.
+func (r *NameserverGroupEnabledResource) setEnabled(ctx context.Context, data *NameserverGroupEnabledResourceModel) diag.Diagnostics {
+	group, diags := r.getNameserverGroup(ctx, data.NameserverGroupID.ValueString())
+	if diags.HasError() {
+		return diags
+	}
+	if group == nil {
+		diags.AddAttributeError(
+			path.Root("nameserver_group_id"),
+			"Nameserver group not found",
+			fmt.Sprintf("No nameserver group exists with ID %q.", data.NameserverGroupID.ValueString()),
+		)
+		return diags
+	}
+
+	// Skip the update when the group already has the requested state
+	if group.Enabled != data.Enabled.ValueBool() {
+		_, err := putJSON[netbirdApi.NameserverGroup](ctx, r.client, fmt.Sprintf("/api/dns/nameservers/%s", group.Id), netbirdApi.NameserverGroupRequest{
+			Name:                 group.Name,
+			Description:          group.Description,
+			Nameservers:          group.Nameservers,
+			Groups:               group.Groups,
+			Primary:              group.Primary,
+			Domains:              group.Domains,
+			SearchDomainsEnabled: group.SearchDomainsEnabled,
+			Enabled:              data.Enabled.ValueBool(),
+		})
+		if err != nil {
+			diags.AddError("Error updating nameserver group", err.Error())
+			return diags
+		}
+	}
+
+	data.ID = types.StringValue(group.Id)
+	return diags
+}
+
+func (r *NameserverGroupEnabledResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data NameserverGroupEnabledResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.setEnabled(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *NameserverGroupEnabledResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data NameserverGroupEnabledResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	group, diags := r.getNameserverGroup(ctx, data.ID.ValueString())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The nameserver group has been deleted
+	if group == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.NameserverGroupID = types.StringValue(group.Id)
+	data.Enabled = types.BoolValue(group.Enabled)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *NameserverGroupEnabledResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data NameserverGroupEnabledResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.setEnabled(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *NameserverGroupEnabledResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// The nameserver group is left in its current state.
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *NameserverGroupEnabledResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -0,0 +1,64 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	netbirdApi "github.com/netbirdio/netbird/management/server/http/api"
+)
+
+func TestAccNameserverGroupEnabledResource(t *testing.T) {
+	testAccMockOnly(t)
+	providerConfig, mock := testAccProviderConfig(t)
+	id := mock.Seed("/api/dns/nameservers", netbirdApi.NameserverGroup{
+		Name:        "tf-acc-nameservers",
+		Nameservers: []netbirdApi.Nameserver{{Ip: "1.1.1.1", NsType: netbirdApi.NameserverNsTypeUdp, Port: 53}},
+		Groups:      []string{"group-id"},
+		Primary:     true,
+		Domains:     []string{},
+		Enabled:     true,
+	})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing, the refresh after apply verifies the group was updated
+			{
+				Config: providerConfig + testAccNameserverGroupEnabledResourceConfig(id, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("netbird_nameserver_group_enabled.test", "id", id),
+					resource.TestCheckResourceAttr("netbird_nameserver_group_enabled.test", "enabled", "false"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "netbird_nameserver_group_enabled.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + testAccNameserverGroupEnabledResourceConfig(id, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("netbird_nameserver_group_enabled.test", "enabled", "true"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+
+	if !mock.Exists("/api/dns/nameservers", id) {
+		t.Error("destroying the resource should not delete the nameserver group")
+	}
+}
+
+func testAccNameserverGroupEnabledResourceConfig(id string, enabled bool) string {
+	return fmt.Sprintf(`
+resource "netbird_nameserver_group_enabled" "test" {
+  nameserver_group_id = %q
+  enabled             = %t
+}
+`, id, enabled)
+}
@@ -1,18 +1,18 @@
 package provider
 
 import (
-	"bytes"
 	"context"
-	"encoding/json"
 	"fmt"
-	"net/http"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	netbirdApi "github.com/netbirdio/netbird/management/server/http/api"
 )
@@ -20,6 +20,8 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &NameserverGroupResource{}
 var _ resource.ResourceWithImportState = &NameserverGroupResource{}
+var _ resource.ResourceWithIdentity = &NameserverGroupResource{}
+var _ resource.ResourceWithConfigValidators = &NameserverGroupResource{}
 
 func NewNameserverGroupResource() resource.Resource {
 	return &NameserverGroupResource{}
@@ -27,7 +29,7 @@ func NewNameserverGroupResource() resource.Resource {
 
 // NameserverGroupResource defines the resource implementation.
 type NameserverGroupResource struct {
-	client *Client
+	client ClientInterface
 }
 
 type NameserverResourceModel struct {
@@ -41,6 +43,7 @@ type NameserverGroupResourceModel struct {
 	Name                 types.String              `tfsdk:"name"`
 	Description          types.String              `tfsdk:"description"`
 	Nameservers          []NameserverResourceModel `tfsdk:"nameservers"`
+	NameserversCount     types.Int64               `tfsdk:"nameservers_count"`
 	PeerGroups           types.List                `tfsdk:"peer_groups"`
 	Domains              types.List                `tfsdk:"domains"`
 	Primary              types.Bool                `tfsdk:"primary"`
@@ -75,8 +78,10 @@ func (r *NameserverGroupResource) Schema(ctx context.Context, req resource.Schem
 			},
 			"peer_groups": schema.ListAttribute{
 				ElementType:         types.StringType,
-				MarkdownDescription: "Peer group IDs that defines group of peers that will use this nameserver group",
+				MarkdownDescription: "Peer group IDs that defines group of peers that will use this nameserver group. Defaults to no groups",
 				Optional:            true,
+				Computed:            true,
+				Default:             listdefault.StaticValue(types.ListValueMust(types.StringType, []attr.Value{})),
 			},
 			"primary": schema.BoolAttribute{
 				MarkdownDescription: "Defines if a nameserver group is primary that resolves all domains. It should be true only if domains list is empty.",
@@ -102,13 +107,24 @@ func (r *NameserverGroupResource) Schema(ctx context.Context, req resource.Schem
 					},
 				},
 			},
+			"nameservers_count": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Number of nameservers in the group",
+				PlanModifiers: []planmodifier.Int64{
+					int64UseStateUnlessChanged(path.Root("nameservers")),
+				},
+			},
 			"domains": schema.ListAttribute{
-				ElementType:         types.StringType,
-				MarkdownDescription: "Match domain list. It should be empty only if primary is true.",
-				Required:            true,
+				ElementType: types.StringType,
+				MarkdownDescription: "Match domain list. It should be empty only if primary is true. " +
+					"Accepts domains such as `example.com` and wildcards such as `*.example.com`.",
+				Required: true,
+				Validators: []validator.List{
+					domainListValidator{},
+				},
 			},
 			"search_domains_enabled": schema.BoolAttribute{
-				MarkdownDescription: "Search domain status for match domains. It should be true only if domains list is not empty.",
+				MarkdownDescription: "Search domain status for match domains. Can only be true when `domains` is not empty.",
 				Required:            true,
 			},
 
@@ -120,13 +136,99 @@ func (r *NameserverGroupResource) Schema(ctx context.Context, req resource.Schem
 	}
 }
 
+func (r *NameserverGroupResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		nameserverGroupDomainsValidator{},
+		nameserverGroupSearchDomainsValidator{},
+	}
+}
+
+// nameserverGroupDomainsValidator checks that match domains are only set for non-primary nameserver groups.
+type nameserverGroupDomainsValidator struct{}
+
+func (v nameserverGroupDomainsValidator) Description(ctx context.Context) string {
+	return "domains must be empty when primary is true, and must not be empty when primary is false"
+}
+
+func (v nameserverGroupDomainsValidator) MarkdownDescription(ctx context.Context) string {
+	return "`domains` must be empty when `primary` is `true`, and must not be empty when `primary` is `false`"
+}
+
+func (v nameserverGroupDomainsValidator) ValidateResource(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var primary types.Bool
+	var domains types.List
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("primary"), &primary)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("domains"), &domains)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Values may not be known until apply
+	if primary.IsNull() || primary.IsUnknown() || domains.IsUnknown() {
+		return
+	}
+
+	if !primary.ValueBool() && len(domains.Elements()) == 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("domains"),
+			"Missing match domains",
+			"A nameserver group that is not primary must have at least one match domain. Add domains or set primary to true.",
+		)
+	}
+	if primary.ValueBool() && len(domains.Elements()) > 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("domains"),
+			"Unexpected match domains",
+			"A primary nameserver group resolves all domains, so domains must be empty. Remove the domains or set primary to false.",
+		)
+	}
+}
+
+// nameserverGroupSearchDomainsValidator checks that search domains are only enabled when there are match domains.
+type nameserverGroupSearchDomainsValidator struct{}
+
+func (v nameserverGroupSearchDomainsValidator) Description(ctx context.Context) string {
+	return "search_domains_enabled must be false when domains is empty"
+}
+
+func (v nameserverGroupSearchDomainsValidator) MarkdownDescription(ctx context.Context) string {
+	return "`search_domains_enabled` must be `false` when `domains` is empty"
+}
+
+func (v nameserverGroupSearchDomainsValidator) ValidateResource(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var searchDomainsEnabled types.Bool
+	var domains types.List
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("search_domains_enabled"), &searchDomainsEnabled)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("domains"), &domains)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Values may not be known until apply
+	if searchDomainsEnabled.IsUnknown() || domains.IsUnknown() {
+		return
+	}
+
+	if searchDomainsEnabled.ValueBool() && len(domains.Elements()) == 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("search_domains_enabled"),
+			"Search domains without match domains",
+			"Search domains can only be enabled when the nameserver group has match domains. Add domains or set search_domains_enabled to false.",
+		)
+	}
+}
+
+func (r *NameserverGroupResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentitySchema("nameserver group")
+}
+
 func (r *NameserverGroupResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	// Prevent panic if the provider has not been configured.
 	if req.ProviderData == nil {
 		return
 	}
 
-	client, ok := req.ProviderData.(*Client)
+	client, ok := req.ProviderData.(ClientInterface)
 
 	if !ok {
 		resp.Diagnostics.AddError(
@@ -150,48 +252,23 @@ func (r *NameserverGroupResource) Create(ctx context.Context, req resource.Creat
 		return
 	}
 
-	apiData, diags := nameserverGroupModelToApiRequest(data)
+	apiData, diags := nameserverGroupModelToApi(data)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	if apiData == nil {
-		resp.Diagnostics.AddError("nul pointer error", "Got nil pointer to NameserverGroupResourceModel")
-		return
-	}
-
-	requestBody, err := json.Marshal(apiData)
-	if err != nil {
-		resp.Diagnostics.AddError("Error marshaling request body", err.Error())
-		return
-	}
 
 	// Make API request
-	reqURL := fmt.Sprintf("%s/api/dns/nameservers", r.client.BaseUrl)
-	httpReq, err := http.NewRequest("POST", reqURL, bytes.NewBuffer(requestBody))
-	if err != nil {
-		resp.Diagnostics.AddError("Error creating request", err.Error())
-		return
-	}
-	httpReq.Header.Set("Content-Type", "application/json")
-
-	responseBody, err := r.client.doRequest(httpReq)
+	responseData, err := postJSON[netbirdApi.NameserverGroup](ctx, r.client, "/api/dns/nameservers", apiData)
 	if err != nil {
 		resp.Diagnostics.AddError("Error making API request", err.Error())
 		return
 	}
 
-	// Parse response
-	var responseData netbirdApi.NameserverGroup
-	if err := json.Unmarshal(responseBody, &responseData); err != nil {
-		resp.Diagnostics.AddError("Error parsing response", err.Error())
-		return
-	}
-
 	// Assign values from API response
 	data.ID = types.StringValue(responseData.Id)
 
-	diags = r.readNameserverGroupIntoModel(&data)
+	diags = r.readNameserverGroupIntoModel(ctx, &data)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -199,6 +276,7 @@ func (r *NameserverGroupResource) Create(ctx context.Context, req resource.Creat
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIDIdentity(ctx, resp.Identity, data.ID)...)
 }
 
 func (r *NameserverGroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -211,89 +289,98 @@ func (r *NameserverGroupResource) Read(ctx context.Context, req resource.ReadReq
 		return
 	}
 
-	diags := r.readNameserverGroupIntoModel(&data)
+	// The framework requires an identity even when the object has been deleted
+	resp.Diagnostics.Append(setIDIdentity(ctx, resp.Identity, data.ID)...)
+
+	diags := r.readNameserverGroupIntoModel(ctx, &data)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	// The object has been deleted outside of Terraform
+	if data.ID.IsNull() {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
-func (r *NameserverGroupResource) readNameserverGroupIntoModel(data *NameserverGroupResourceModel) diag.Diagnostics {
+func (r *NameserverGroupResource) readNameserverGroupIntoModel(ctx context.Context, data *NameserverGroupResourceModel) diag.Diagnostics {
 	// Update network model
 	// Fetch data from API
 	diags := diag.Diagnostics{}
 	if data == nil {
 		return diags
 	}
-	reqURL := fmt.Sprintf("%s/api/dns/nameservers/%s", r.client.BaseUrl, data.ID.ValueString())
-	httpReq, err := http.NewRequest("GET", reqURL, nil)
+	responseData, err := getJSON[netbirdApi.NameserverGroup](ctx, r.client, fmt.Sprintf("/api/dns/nameservers/%s", data.ID.ValueString()))
 	if err != nil {
-		diags.AddError("Error creating request", err.Error())
-		return diags
-	}
-
-	responseBody, err := r.client.doRequest(httpReq)
-	if err != nil {
-		diags.AddError("Error fetching network", err.Error())
+		diags.AddError("Error fetching nameserver group", err.Error())
 		return diags
 	}
 	// If not found
-	if responseBody == nil {
+	if responseData == nil {
 		data.ID = types.StringNull()
 		return diags
 	}
 
-	var responseData netbirdApi.NameserverGroup
-	if err := json.Unmarshal(responseBody, &responseData); err != nil {
-		diags.AddError("Error parsing response", err.Error())
-		return diags
-	}
-
-	data.Name = types.StringValue(responseData.Name)
-	data.Description = nullStringToEmptyString(derefString(&responseData.Description))
+	*data, diags = nameserverGroupToModel(*responseData, *data)
+	return diags
+}
 
-	var nameservers []NameserverResourceModel
-	for _, nameserver := range responseData.Nameservers {
-		nameservers = append(nameservers, NameserverResourceModel{
+// nameserverGroupToModel converts a nameserver group returned by the API into
+// its resource model. domains are kept null where they are null in prior, see
+// preserveNullList.
+func nameserverGroupToModel(group netbirdApi.NameserverGroup, prior NameserverGroupResourceModel) (NameserverGroupResourceModel, diag.Diagnostics) {
+	data := NameserverGroupResourceModel{
+		ID:                   types.StringValue(group.Id),
+		Name:                 types.StringValue(group.Name),
+		Description:          nullStringToEmptyString(types.StringValue(group.Description)),
+		Primary:              types.BoolValue(group.Primary),
+		SearchDomainsEnabled: types.BoolValue(group.SearchDomainsEnabled),
+		Enabled:              types.BoolValue(group.Enabled),
+	}
+
+	for _, nameserver := range group.Nameservers {
+		data.Nameservers = append(data.Nameservers, NameserverResourceModel{
 			Ip:     types.StringValue(nameserver.Ip),
 			NsType: types.StringValue(string(nameserver.NsType)),
 			Port:   types.Int32Value(int32(nameserver.Port)),
 		})
 	}
-	data.Nameservers = nameservers
+	data.NameserversCount = types.Int64Value(int64(len(group.Nameservers)))
 
-	data.PeerGroups, diags = convertStringSliceToListValue(responseData.Groups)
+	// Without groups the list is empty rather than null, matching the default
+	peerGroups, diags := convertStringSliceToListValue(append([]string{}, group.Groups...))
 	if diags.HasError() {
-		return diags
+		return data, diags
 	}
+	data.PeerGroups = peerGroups
 
-	data.Primary = types.BoolPointerValue(&responseData.Primary)
-
-	data.Domains, diags = convertStringSliceToListValue(responseData.Domains)
+	domains, diags := convertStringSliceToListValue(group.Domains)
 	if diags.HasError() {
-		return diags
+		return data, diags
 	}
+	data.Domains = preserveNullList(domains, prior.Domains)
 
-	data.SearchDomainsEnabled = types.BoolPointerValue(&responseData.SearchDomainsEnabled)
-	data.Enabled = types.BoolPointerValue(&responseData.Enabled)
-
-	return diags
+	return data, diags
 }
 
-func nameserverGroupModelToApiRequest(data NameserverGroupResourceModel) (*netbirdApi.NameserverGroupRequest, diag.Diagnostics) {
+// nameserverGroupModelToApi converts a resource model into the request that
+// creates or updates the nameserver group, the inverse of nameserverGroupToModel.
+func nameserverGroupModelToApi(data NameserverGroupResourceModel) (netbirdApi.NameserverGroupRequest, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
 	peerGroups, diags := convertListToStringSlice(data.PeerGroups)
 	if diags.HasError() {
-		return nil, diags
+		return netbirdApi.NameserverGroupRequest{}, diags
 	}
 
 	domains, diags := convertListToStringSlice(data.Domains)
 	if diags.HasError() {
-		return nil, diags
+		return netbirdApi.NameserverGroupRequest{}, diags
 	}
 
 	var nameservers []netbirdApi.Nameserver
@@ -305,7 +392,7 @@ func nameserverGroupModelToApiRequest(data NameserverGroupResourceModel) (*netbi
 		})
 	}
 
-	return &netbirdApi.NameserverGroupRequest{
+	return netbirdApi.NameserverGroupRequest{
 		Name:                 data.Name.ValueString(),
 		Description:          data.Description.ValueString(),
 		Nameservers:          nameservers,
@@ -327,37 +414,19 @@ func (r *NameserverGroupResource) Update(ctx context.Context, req resource.Updat
 		return
 	}
 
-	apiData, diags := nameserverGroupModelToApiRequest(data)
+	apiData, diags := nameserverGroupModelToApi(data)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	if apiData == nil {
-		resp.Diagnostics.AddError("nul pointer error", "Got nil pointer to NameserverGroupResourceModel")
-		return
-	}
-
-	requestBody, err := json.Marshal(&apiData)
-	if err != nil {
-		resp.Diagnostics.AddError("Error marshaling request body", err.Error())
-		return
-	}
 
-	reqURL := fmt.Sprintf("%s/api/dns/nameservers/%s", r.client.BaseUrl, data.ID.ValueString())
-	httpReq, err := http.NewRequest("PUT", reqURL, bytes.NewBuffer(requestBody))
+	_, err := putJSON[netbirdApi.NameserverGroup](ctx, r.client, fmt.Sprintf("/api/dns/nameservers/%s", data.ID.ValueString()), apiData)
 	if err != nil {
-		resp.Diagnostics.AddError("Error creating request", err.Error())
+		resp.Diagnostics.AddError("Error updating nameserver group", err.Error())
 		return
 	}
-	httpReq.Header.Set("Content-Type", "application/json")
 
-	_, err = r.client.doRequest(httpReq)
-	if err != nil {
-		resp.Diagnostics.AddError("Error updating network", err.Error())
-		return
-	}
-
-	diags = r.readNameserverGroupIntoModel(&data)
+	diags = r.readNameserverGroupIntoModel(ctx, &data)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -365,6 +434,7 @@ func (r *NameserverGroupResource) Update(ctx context.Context, req resource.Updat
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIDIdentity(ctx, resp.Identity, data.ID)...)
 }
 
 func (r *NameserverGroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -377,16 +447,9 @@ func (r *NameserverGroupResource) Delete(ctx context.Context, req resource.Delet
 		return
 	}
 
-	reqURL := fmt.Sprintf("%s/api/dns/nameservers/%s", r.client.BaseUrl, data.ID.ValueString())
-	httpReq, err := http.NewRequest("DELETE", reqURL, nil)
-	if err != nil {
-		resp.Diagnostics.AddError("Error creating request", err.Error())
-		return
-	}
-
-	_, err = r.client.doRequest(httpReq)
+	err := deleteObject(ctx, r.client, fmt.Sprintf("/api/dns/nameservers/%s", data.ID.ValueString()))
 	if err != nil {
-		resp.Diagnostics.AddError("Error deleting network", err.Error())
+		resp.Diagnostics.AddError("Error deleting nameserver group", err.Error())
 		return
 	}
 
@@ -394,5 +457,5 @@ func (r *NameserverGroupResource) Delete(ctx context.Context, req resource.Delet
 }
 
 func (r *NameserverGroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
 }
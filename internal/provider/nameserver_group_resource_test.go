@@ -0,0 +1,253 @@
+package provider
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	netbirdApi "github.com/netbirdio/netbird/management/server/http/api"
+)
+
+func TestAccNameserverGroupResource(t *testing.T) {
+	providerConfig, mock := testAccProviderConfig(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckDestroy(mock, "netbird_nameserver_group", staticPath("/api/dns/nameservers")),
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + testAccNameserverGroupResourceConfig("1.1.1.1", true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("netbird_nameserver_group.test", "name", "tf-acc-nameservers"),
+					resource.TestCheckResourceAttr("netbird_nameserver_group.test", "nameservers.#", "1"),
+					resource.TestCheckResourceAttr("netbird_nameserver_group.test", "nameservers.0.ip", "1.1.1.1"),
+					resource.TestCheckResourceAttr("netbird_nameserver_group.test", "nameservers_count", "1"),
+					resource.TestCheckResourceAttr("netbird_nameserver_group.test", "domains.0", "example.com"),
+					resource.TestCheckResourceAttr("netbird_nameserver_group.test", "enabled", "true"),
+					resource.TestCheckResourceAttrPair("netbird_nameserver_group.test", "peer_groups.0", "netbird_group.test", "id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "netbird_nameserver_group.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + testAccNameserverGroupResourceConfig("8.8.8.8", false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("netbird_nameserver_group.test", "nameservers.0.ip", "8.8.8.8"),
+					resource.TestCheckResourceAttr("netbird_nameserver_group.test", "enabled", "false"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccNameserverGroupResource_noPeerGroups(t *testing.T) {
+	providerConfig, mock := testAccProviderConfig(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckDestroy(mock, "netbird_nameserver_group", staticPath("/api/dns/nameservers")),
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + testAccNameserverGroupResourceDomainsConfig(false, `["example.com"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("netbird_nameserver_group.test", "peer_groups.#", "0"),
+				),
+			},
+			// Re-applying the same configuration must not show a diff
+			{
+				Config:   providerConfig + testAccNameserverGroupResourceDomainsConfig(false, `["example.com"]`),
+				PlanOnly: true,
+			},
+			{
+				ResourceName:      "netbird_nameserver_group.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccNameserverGroupResource_emptyDomains(t *testing.T) {
+	providerConfig, mock := testAccProviderConfig(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckDestroy(mock, "netbird_nameserver_group", staticPath("/api/dns/nameservers")),
+		Steps: []resource.TestStep{
+			// An empty list is kept rather than read back as null
+			{
+				Config: providerConfig + testAccNameserverGroupResourceDomainsConfig(true, "[]"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("netbird_nameserver_group.test", "domains.#", "0"),
+				),
+			},
+			{
+				Config:   providerConfig + testAccNameserverGroupResourceDomainsConfig(true, "[]"),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccNameserverGroupResource_domainsValidation(t *testing.T) {
+	providerConfig, _ := testAccProviderConfig(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      providerConfig + testAccNameserverGroupResourceDomainsConfig(false, "[]"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("Missing match domains"),
+			},
+			{
+				Config:      providerConfig + testAccNameserverGroupResourceDomainsConfig(true, `["example.com"]`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("Unexpected match domains"),
+			},
+			{
+				Config:      providerConfig + testAccNameserverGroupResourceSearchDomainsConfig,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("Search domains without match domains"),
+			},
+		},
+	})
+}
+
+const testAccNameserverGroupResourceSearchDomainsConfig = `
+resource "netbird_nameserver_group" "test" {
+  name = "tf-acc-nameservers"
+  nameservers = [
+    {
+      ip      = "1.1.1.1"
+      ns_type = "udp"
+      port    = 53
+    }
+  ]
+  primary                = true
+  domains                = []
+  search_domains_enabled = true
+  enabled                = true
+}
+`
+
+func testAccNameserverGroupResourceDomainsConfig(primary bool, domains string) string {
+	return fmt.Sprintf(`
+resource "netbird_nameserver_group" "test" {
+  name = "tf-acc-nameservers"
+  nameservers = [
+    {
+      ip      = "1.1.1.1"
+      ns_type = "udp"
+      port    = 53
+    }
+  ]
+  primary                = %t
+  domains                = %s
+  search_domains_enabled = false
+  enabled                = true
+}
+`, primary, domains)
+}
+
+func testAccNameserverGroupResourceConfig(ip string, enabled bool) string {
+	return fmt.Sprintf(`
+resource "netbird_group" "test" {
+  name = "tf-acc-nameserver-group"
+}
+
+resource "netbird_nameserver_group" "test" {
+  name        = "tf-acc-nameservers"
+  peer_groups = [netbird_group.test.id]
+  nameservers = [
+    {
+      ip      = %q
+      ns_type = "udp"
+      port    = 53
+    }
+  ]
+  primary                = false
+  domains                = ["example.com"]
+  search_domains_enabled = true
+  enabled                = %t
+}
+`, ip, enabled)
+}
+
+func TestNameserverGroupConversionRoundTrip(t *testing.T) {
+	request := netbirdApi.NameserverGroupRequest{
+		Name:        "tf-acc-nameservers",
+		Description: "Internal resolvers",
+		Nameservers: []netbirdApi.Nameserver{
+			{Ip: "10.0.0.53", NsType: netbirdApi.NameserverNsTypeUdp, Port: 53},
+			{Ip: "10.0.1.53", NsType: netbirdApi.NameserverNsTypeUdp, Port: 5353},
+		},
+		Groups:               []string{"g1", "g2"},
+		Domains:              []string{"example.com"},
+		SearchDomainsEnabled: true,
+		Enabled:              true,
+	}
+	group := netbirdApi.NameserverGroup{
+		Id:                   "ns1",
+		Name:                 request.Name,
+		Description:          request.Description,
+		Nameservers:          request.Nameservers,
+		Groups:               request.Groups,
+		Domains:              request.Domains,
+		Primary:              request.Primary,
+		SearchDomainsEnabled: request.SearchDomainsEnabled,
+		Enabled:              request.Enabled,
+	}
+
+	data, diags := nameserverGroupToModel(group, NameserverGroupResourceModel{})
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if data.ID.ValueString() != "ns1" {
+		t.Errorf("expected ID ns1, got %s", data.ID)
+	}
+	if data.NameserversCount.ValueInt64() != 2 {
+		t.Errorf("expected 2 nameservers, got %s", data.NameserversCount)
+	}
+
+	got, diags := nameserverGroupModelToApi(data)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if !reflect.DeepEqual(got, request) {
+		t.Errorf("expected %+v, got %+v", request, got)
+	}
+}
+
+func TestNameserverGroupToModelEmptyLists(t *testing.T) {
+	prior := NameserverGroupResourceModel{Domains: types.ListNull(types.StringType)}
+	data, diags := nameserverGroupToModel(netbirdApi.NameserverGroup{Id: "ns1"}, prior)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	// peer_groups defaults to an empty list, domains stay null when unset
+	if data.PeerGroups.IsNull() || len(data.PeerGroups.Elements()) != 0 {
+		t.Errorf("expected empty peer_groups, got %s", data.PeerGroups)
+	}
+	if !data.Domains.IsNull() {
+		t.Errorf("expected null domains, got %s", data.Domains)
+	}
+	if !data.Description.IsNull() {
+		t.Errorf("expected null description, got %s", data.Description)
+	}
+}
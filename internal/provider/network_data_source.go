@@ -0,0 +1,250 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	netbirdApi "github.com/netbirdio/netbird/management/server/http/api"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &NetworkDataSource{}
+
+func NewNetworkDataSource() datasource.DataSource {
+	return &NetworkDataSource{}
+}
+
+// NetworkDataSource defines the data source implementation.
+type NetworkDataSource struct {
+	client ClientInterface
+}
+
+func (d *NetworkDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_network"
+}
+
+func (d *NetworkDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Retrieve network details. Set `expand` to also fetch the routers and resources of the network.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Required:    true,
+				Description: "Unique identifier of the network.",
+			},
+			"name": schema.StringAttribute{
+				Computed:    true,
+				Description: "Name of the network.",
+			},
+			"description": schema.StringAttribute{
+				Computed:    true,
+				Description: "Description of the network.",
+			},
+			"routing_peers_count": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Number of routing peers in the network.",
+			},
+			"routers": schema.ListAttribute{
+				Computed:    true,
+				Description: "List of router IDs associated with the network.",
+				ElementType: types.StringType,
+			},
+			"resources": schema.ListAttribute{
+				Computed:    true,
+				Description: "List of resource IDs associated with the network.",
+				ElementType: types.StringType,
+			},
+			"policies": schema.ListAttribute{
+				Computed:    true,
+				Description: "List of policy IDs associated with the network.",
+				ElementType: types.StringType,
+			},
+			"expand": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: "Fetch the routers and resources of the network into `router_details` and `resource_details`. This costs two additional API requests.",
+			},
+			"router_details": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Routers of the network. Only populated when expand is set.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "Unique identifier of the router.",
+						},
+						"peer": schema.StringAttribute{
+							Computed:    true,
+							Description: "Peer ID acting as the router.",
+						},
+						"peer_groups": schema.ListAttribute{
+							Computed:    true,
+							Description: "Peer group IDs acting as the router.",
+							ElementType: types.StringType,
+						},
+						"metric": schema.Int64Attribute{
+							Computed:    true,
+							Description: "Route metric number. Lowest number has higher priority.",
+						},
+						"masquerade": schema.BoolAttribute{
+							Computed:    true,
+							Description: "Indicates whether the router masquerades traffic.",
+						},
+						"enabled": schema.BoolAttribute{
+							Computed:    true,
+							Description: "Indicates whether the router is enabled.",
+						},
+					},
+				},
+			},
+			"resource_details": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Resources of the network. Only populated when expand is set.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "Unique identifier of the resource.",
+						},
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "Name of the resource.",
+						},
+						"description": schema.StringAttribute{
+							Computed:    true,
+							Description: "Description of the resource.",
+						},
+						"address": schema.StringAttribute{
+							Computed:    true,
+							Description: "Address of the resource.",
+						},
+						"type": schema.StringAttribute{
+							Computed:    true,
+							Description: "Type of the resource, derived from the address.",
+						},
+						"groups": schema.ListAttribute{
+							Computed:    true,
+							Description: "Group IDs containing the resource.",
+							ElementType: types.StringType,
+						},
+						"enabled": schema.BoolAttribute{
+							Computed:    true,
+							Description: "Indicates whether the resource is enabled.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *NetworkDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(ClientInterface)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *NetworkDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data NetworkDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	network, diags := getNetworkDataSourceObject[netbirdApi.Network](ctx, d.client, fmt.Sprintf("/api/networks/%s", data.ID.ValueString()))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Name = types.StringValue(network.Name)
+	data.Description = derefString(network.Description)
+	data.RoutingPeersCount = types.Int64Value(int64(network.RoutingPeersCount))
+
+	data.Routers, diags = types.ListValueFrom(ctx, types.StringType, network.Routers)
+	resp.Diagnostics.Append(diags...)
+	data.Resources, diags = types.ListValueFrom(ctx, types.StringType, network.Resources)
+	resp.Diagnostics.Append(diags...)
+	data.Policies, diags = types.ListValueFrom(ctx, types.StringType, network.Policies)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Expand.ValueBool() {
+		routers, diags := getNetworkDataSourceObject[[]netbirdApi.NetworkRouter](ctx, d.client, fmt.Sprintf("/api/networks/%s/routers", network.Id))
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		for _, router := range *routers {
+			peerGroups, diags := types.ListValueFrom(ctx, types.StringType, derefStringSlice(router.PeerGroups))
+			resp.Diagnostics.Append(diags...)
+			data.RouterDetails = append(data.RouterDetails, NetworkRouterDataSourceModel{
+				ID:         types.StringValue(router.Id),
+				Peer:       derefString(router.Peer),
+				PeerGroups: peerGroups,
+				Metric:     types.Int64Value(int64(router.Metric)),
+				Masquerade: types.BoolValue(router.Masquerade),
+				Enabled:    types.BoolValue(router.Enabled),
+			})
+		}
+
+		resources, diags := getNetworkDataSourceObject[[]netbirdApi.NetworkResource](ctx, d.client, fmt.Sprintf("/api/networks/%s/resources", network.Id))
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		for _, res := range *resources {
+			groups, diags := convertGroupMinimumToIdList(&res.Groups)
+			resp.Diagnostics.Append(diags...)
+			data.ResourceDetails = append(data.ResourceDetails, NetworkResourceDataSourceModel{
+				ID:          types.StringValue(res.Id),
+				Name:        types.StringValue(res.Name),
+				Description: derefString(res.Description),
+				Address:     types.StringValue(res.Address),
+				Type:        types.StringValue(string(res.Type)),
+				Groups:      groups,
+				Enabled:     types.BoolValue(res.Enabled),
+			})
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// getNetworkDataSourceObject fetches path from the API, failing if it does not exist.
+func getNetworkDataSourceObject[T any](ctx context.Context, client ClientInterface, path string) (*T, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	result, err := getJSON[T](ctx, client, path)
+	if err != nil {
+		diags.AddError("Error Making API Request: "+path, err.Error())
+		return nil, diags
+	}
+	if result == nil {
+		diags.AddError("Network Not Found", "No network found at "+path)
+	}
+	return result, diags
+}
@@ -0,0 +1,73 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccNetworkDataSource(t *testing.T) {
+	providerConfig, _ := testAccProviderConfig(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + testAccNetworkDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.netbird_network.test", "name", "tf-acc-network-ds"),
+					resource.TestCheckResourceAttr("data.netbird_network.test", "routers.#", "1"),
+					resource.TestCheckResourceAttr("data.netbird_network.test", "resources.#", "1"),
+					resource.TestCheckNoResourceAttr("data.netbird_network.test", "router_details"),
+					resource.TestCheckResourceAttr("data.netbird_network.expanded", "router_details.#", "1"),
+					resource.TestCheckResourceAttr("data.netbird_network.expanded", "router_details.0.metric", "10"),
+					resource.TestCheckResourceAttrPair("data.netbird_network.expanded", "router_details.0.peer_groups.0", "netbird_group.test", "id"),
+					resource.TestCheckResourceAttr("data.netbird_network.expanded", "resource_details.#", "1"),
+					resource.TestCheckResourceAttr("data.netbird_network.expanded", "resource_details.0.address", "10.20.0.0/24"),
+					resource.TestCheckResourceAttr("data.netbird_network.expanded", "resource_details.0.type", "subnet"),
+					resource.TestCheckResourceAttr("data.netbird_network.expanded", "resource_details.0.enabled", "true"),
+				),
+			},
+		},
+	})
+}
+
+const testAccNetworkDataSourceConfig = `
+resource "netbird_network" "test" {
+  name = "tf-acc-network-ds"
+}
+
+resource "netbird_group" "test" {
+  name = "tf-acc-network-ds"
+}
+
+resource "netbird_network_router" "test" {
+  network_id  = netbird_network.test.id
+  peer_groups = [netbird_group.test.id]
+  metric      = 10
+  masquerade  = true
+  enabled     = true
+}
+
+resource "netbird_network_resource" "test" {
+  network_id  = netbird_network.test.id
+  name        = "tf-acc-network-ds"
+  address     = "10.20.0.0/24"
+  peer_groups = [netbird_group.test.id]
+  enabled     = true
+}
+
+data "netbird_network" "test" {
+  id = netbird_network.test.id
+
+  depends_on = [netbird_network_router.test, netbird_network_resource.test]
+}
+
+data "netbird_network" "expanded" {
+  id     = netbird_network.test.id
+  expand = true
+
+  depends_on = [netbird_network_router.test, netbird_network_resource.test]
+}
+`
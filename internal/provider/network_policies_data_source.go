@@ -0,0 +1,141 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	netbirdApi "github.com/netbirdio/netbird/management/server/http/api"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &NetworkPoliciesDataSource{}
+
+func NewNetworkPoliciesDataSource() datasource.DataSource {
+	return &NetworkPoliciesDataSource{}
+}
+
+// NetworkPoliciesDataSource expands the policy IDs of a network into policy details.
+type NetworkPoliciesDataSource struct {
+	client ClientInterface
+}
+
+func (d *NetworkPoliciesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_network_policies"
+}
+
+func (d *NetworkPoliciesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Retrieve the policies applying to a network, i.e. the policies listed in the `policies` of a `netbird_network`, " +
+			"with their names and status. Useful to detect policies being disabled or changed outside of Terraform.",
+
+		Attributes: map[string]schema.Attribute{
+			"network_id": schema.StringAttribute{
+				Required:    true,
+				Description: "Unique identifier of the network.",
+			},
+			"policies": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Policies of the network, in the order the network lists them.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "Unique identifier of the policy.",
+						},
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "Name of the policy.",
+						},
+						"description": schema.StringAttribute{
+							Computed:    true,
+							Description: "Description of the policy.",
+						},
+						"enabled": schema.BoolAttribute{
+							Computed:    true,
+							Description: "Indicates whether the policy is enabled.",
+						},
+						"rules_count": schema.Int64Attribute{
+							Computed:    true,
+							Description: "Number of rules in the policy.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *NetworkPoliciesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(ClientInterface)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *NetworkPoliciesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data NetworkPoliciesDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	network, diags := getNetworkDataSourceObject[netbirdApi.Network](ctx, d.client, fmt.Sprintf("/api/networks/%s", data.NetworkID.ValueString()))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Fetch every policy in one request rather than one request per policy
+	policies, err := getJSON[[]netbirdApi.Policy](ctx, d.client, "/api/policies")
+	if err != nil {
+		resp.Diagnostics.AddError("Error Making API Request", err.Error())
+		return
+	}
+	policiesByID := map[string]netbirdApi.Policy{}
+	if policies != nil {
+		for _, policy := range *policies {
+			if policy.Id != nil {
+				policiesByID[*policy.Id] = policy
+			}
+		}
+	}
+
+	data.Policies = []NetworkPolicyDataSourceModel{}
+	for _, id := range network.Policies {
+		policy, ok := policiesByID[id]
+		if !ok {
+			// The policy was deleted after the network was read
+			continue
+		}
+		data.Policies = append(data.Policies, NetworkPolicyDataSourceModel{
+			ID:          types.StringValue(id),
+			Name:        types.StringValue(policy.Name),
+			Description: derefString(policy.Description),
+			Enabled:     types.BoolValue(policy.Enabled),
+			RulesCount:  types.Int64Value(int64(len(policy.Rules))),
+		})
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,72 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccNetworkPoliciesDataSource(t *testing.T) {
+	providerConfig, _ := testAccProviderConfig(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + testAccNetworkPoliciesDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.netbird_network_policies.test", "policies.#", "1"),
+					resource.TestCheckResourceAttrPair("data.netbird_network_policies.test", "policies.0.id", "netbird_policy.test", "id"),
+					resource.TestCheckResourceAttr("data.netbird_network_policies.test", "policies.0.name", "tf-acc-network-policies"),
+					resource.TestCheckResourceAttr("data.netbird_network_policies.test", "policies.0.enabled", "false"),
+					resource.TestCheckResourceAttr("data.netbird_network_policies.test", "policies.0.rules_count", "1"),
+				),
+			},
+		},
+	})
+}
+
+const testAccNetworkPoliciesDataSourceConfig = `
+resource "netbird_network" "test" {
+  name = "tf-acc-network-policies"
+}
+
+resource "netbird_group" "test" {
+  name = "tf-acc-network-policies"
+}
+
+resource "netbird_network_resource" "test" {
+  network_id  = netbird_network.test.id
+  name        = "tf-acc-network-policies"
+  address     = "10.30.0.0/24"
+  peer_groups = [netbird_group.test.id]
+  enabled     = true
+}
+
+resource "netbird_policy" "test" {
+  name    = "tf-acc-network-policies"
+  enabled = false
+  rules = [
+    {
+      name          = "tf-acc-rule"
+      enabled       = true
+      action        = "accept"
+      bidirectional = false
+      protocol      = "tcp"
+      ports         = ["5432"]
+      sources       = [netbird_group.test.id]
+      destination_resource = {
+        id   = netbird_network_resource.test.id
+        type = "subnet"
+      }
+    }
+  ]
+}
+
+data "netbird_network_policies" "test" {
+  network_id = netbird_network.test.id
+
+  depends_on = [netbird_policy.test]
+}
+`
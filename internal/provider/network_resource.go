@@ -1,12 +1,10 @@
 package provider
 
 import (
-	"bytes"
 	"context"
-	"encoding/json"
 	"fmt"
-	"net/http"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -20,6 +18,7 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &NetworkResource{}
 var _ resource.ResourceWithImportState = &NetworkResource{}
+var _ resource.ResourceWithIdentity = &NetworkResource{}
 
 func NewNetworkResource() resource.Resource {
 	return &NetworkResource{}
@@ -27,17 +26,18 @@ func NewNetworkResource() resource.Resource {
 
 // NetworkResource defines the resource implementation.
 type NetworkResource struct {
-	client *Client
+	client ClientInterface
 }
 
 type NetworkResourceModel struct {
-	ID                types.String `tfsdk:"id"`
-	Name              types.String `tfsdk:"name"`
-	Description       types.String `tfsdk:"description"`
-	Routers           types.List   `tfsdk:"routers"`
-	RoutingPeersCount types.Int64  `tfsdk:"routing_peers_count"`
-	Resources         types.List   `tfsdk:"resources"`
-	Policies          types.List   `tfsdk:"policies"`
+	ID                types.String   `tfsdk:"id"`
+	Name              types.String   `tfsdk:"name"`
+	Description       types.String   `tfsdk:"description"`
+	Routers           types.List     `tfsdk:"routers"`
+	RoutingPeersCount types.Int64    `tfsdk:"routing_peers_count"`
+	Resources         types.List     `tfsdk:"resources"`
+	Policies          types.List     `tfsdk:"policies"`
+	Timeouts          timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *NetworkResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -85,16 +85,28 @@ func (r *NetworkResource) Schema(ctx context.Context, req resource.SchemaRequest
 				Computed:            true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
+func (r *NetworkResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentitySchema("network")
+}
+
 func (r *NetworkResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	// Prevent panic if the provider has not been configured.
 	if req.ProviderData == nil {
 		return
 	}
 
-	client, ok := req.ProviderData.(*Client)
+	client, ok := req.ProviderData.(ClientInterface)
 
 	if !ok {
 		resp.Diagnostics.AddError(
@@ -118,41 +130,32 @@ func (r *NetworkResource) Create(ctx context.Context, req resource.CreateRequest
 		return
 	}
 
-	requestBody, err := json.Marshal(map[string]string{
-		"name":        data.Name.ValueString(),
-		"description": data.Description.ValueString(),
-	})
-	if err != nil {
-		resp.Diagnostics.AddError("Error marshaling request body", err.Error())
+	createTimeout, diags := data.Timeouts.Create(ctx, 0)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
+	ctx, cancel := timeoutContext(ctx, createTimeout)
+	defer cancel()
 
-	// Make API request
-	reqURL := fmt.Sprintf("%s/api/networks", r.client.BaseUrl)
-	httpReq, err := http.NewRequest("POST", reqURL, bytes.NewBuffer(requestBody))
-	if err != nil {
-		resp.Diagnostics.AddError("Error creating request", err.Error())
-		return
-	}
-	httpReq.Header.Set("Content-Type", "application/json")
+	// Retry failed requests unless they created the network, which would
+	// otherwise be created twice
+	retryCtx := withPostRetry(ctx, lookupCreatedByName(r.client, "/api/networks", data.Name.ValueString()))
 
-	responseBody, err := r.client.doRequest(httpReq)
+	// Make API request
+	responseData, err := postJSON[netbirdApi.Network](retryCtx, r.client, "/api/networks", map[string]string{
+		"name":        data.Name.ValueString(),
+		"description": data.Description.ValueString(),
+	})
 	if err != nil {
 		resp.Diagnostics.AddError("Error making API request", err.Error())
 		return
 	}
 
-	// Parse response
-	var responseData map[string]interface{}
-	if err := json.Unmarshal(responseBody, &responseData); err != nil {
-		resp.Diagnostics.AddError("Error parsing response", err.Error())
-		return
-	}
-
 	// Assign values from API response
-	data.ID = types.StringValue(responseData["id"].(string))
+	data.ID = types.StringValue(responseData.Id)
 
-	diags := r.readIntoModel(ctx, &data)
+	diags = r.readIntoModel(ctx, &data)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -160,6 +163,7 @@ func (r *NetworkResource) Create(ctx context.Context, req resource.CreateRequest
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIDIdentity(ctx, resp.Identity, data.ID)...)
 }
 
 func (r *NetworkResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -172,12 +176,29 @@ func (r *NetworkResource) Read(ctx context.Context, req resource.ReadRequest, re
 		return
 	}
 
-	diags := r.readIntoModel(ctx, &data)
+	// The framework requires an identity even when the object has been deleted
+	resp.Diagnostics.Append(setIDIdentity(ctx, resp.Identity, data.ID)...)
+
+	readTimeout, diags := data.Timeouts.Read(ctx, 0)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := timeoutContext(ctx, readTimeout)
+	defer cancel()
+
+	diags = r.readIntoModel(ctx, &data)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	// The object has been deleted outside of Terraform
+	if data.ID.IsNull() {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -186,30 +207,18 @@ func (r *NetworkResource) readIntoModel(ctx context.Context, data *NetworkResour
 	// Update network model
 	// Fetch data from API
 	diags := diag.Diagnostics{}
-	reqURL := fmt.Sprintf("%s/api/networks/%s", r.client.BaseUrl, data.ID.ValueString())
-	httpReq, err := http.NewRequest("GET", reqURL, nil)
-	if err != nil {
-		diags.AddError("Error creating request", err.Error())
-		return diags
-	}
-
-	responseBody, err := r.client.doRequest(httpReq)
+	responseData, err := getJSON[netbirdApi.Network](ctx, r.client, fmt.Sprintf("/api/networks/%s", data.ID.ValueString()))
 	if err != nil {
 		diags.AddError("Error fetching network", err.Error())
 		return diags
 	}
 
 	// Handle when resource does not exist
-	if responseBody == nil {
+	if responseData == nil {
 		data.ID = types.StringNull()
 		return diags
 	}
 
-	var responseData netbirdApi.Network
-	if err := json.Unmarshal(responseBody, &responseData); err != nil {
-		diags.AddError("Error parsing response", err.Error())
-		return diags
-	}
 	// Update state with latest data
 	data.Name = types.StringValue(responseData.Name)
 
@@ -249,30 +258,24 @@ func (r *NetworkResource) Update(ctx context.Context, req resource.UpdateRequest
 		return
 	}
 
-	requestBody, err := json.Marshal(map[string]string{
-		"name":        data.Name.ValueString(),
-		"description": data.Description.ValueString(),
-	})
-	if err != nil {
-		resp.Diagnostics.AddError("Error marshaling request body", err.Error())
-		return
-	}
-
-	reqURL := fmt.Sprintf("%s/api/networks/%s", r.client.BaseUrl, data.ID.ValueString())
-	httpReq, err := http.NewRequest("PUT", reqURL, bytes.NewBuffer(requestBody))
-	if err != nil {
-		resp.Diagnostics.AddError("Error creating request", err.Error())
+	updateTimeout, diags := data.Timeouts.Update(ctx, 0)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
-	httpReq.Header.Set("Content-Type", "application/json")
+	ctx, cancel := timeoutContext(ctx, updateTimeout)
+	defer cancel()
 
-	_, err = r.client.doRequest(httpReq)
+	_, err := putJSON[netbirdApi.Network](ctx, r.client, fmt.Sprintf("/api/networks/%s", data.ID.ValueString()), map[string]string{
+		"name":        data.Name.ValueString(),
+		"description": data.Description.ValueString(),
+	})
 	if err != nil {
 		resp.Diagnostics.AddError("Error updating network", err.Error())
 		return
 	}
 
-	diags := r.readIntoModel(ctx, &data)
+	diags = r.readIntoModel(ctx, &data)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -280,6 +283,7 @@ func (r *NetworkResource) Update(ctx context.Context, req resource.UpdateRequest
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIDIdentity(ctx, resp.Identity, data.ID)...)
 }
 
 func (r *NetworkResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -292,14 +296,15 @@ func (r *NetworkResource) Delete(ctx context.Context, req resource.DeleteRequest
 		return
 	}
 
-	reqURL := fmt.Sprintf("%s/api/networks/%s", r.client.BaseUrl, data.ID.ValueString())
-	httpReq, err := http.NewRequest("DELETE", reqURL, nil)
-	if err != nil {
-		resp.Diagnostics.AddError("Error creating request", err.Error())
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, 0)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
+	ctx, cancel := timeoutContext(ctx, deleteTimeout)
+	defer cancel()
 
-	_, err = r.client.doRequest(httpReq)
+	err := deleteObject(ctx, r.client, fmt.Sprintf("/api/networks/%s", data.ID.ValueString()))
 	if err != nil {
 		resp.Diagnostics.AddError("Error deleting network", err.Error())
 		return
@@ -309,5 +314,5 @@ func (r *NetworkResource) Delete(ctx context.Context, req resource.DeleteRequest
 }
 
 func (r *NetworkResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
 }
@@ -1,11 +1,8 @@
 package provider
 
 import (
-	"bytes"
 	"context"
-	"encoding/json"
 	"fmt"
-	"net/http"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -20,6 +17,7 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &NetworkResourceResource{}
 var _ resource.ResourceWithImportState = &NetworkResourceResource{}
+var _ resource.ResourceWithIdentity = &NetworkResourceResource{}
 
 func NewNetworkResourceResource() resource.Resource {
 	return &NetworkResourceResource{}
@@ -27,7 +25,7 @@ func NewNetworkResourceResource() resource.Resource {
 
 // NetworkResourceResource defines the resource implementation.
 type NetworkResourceResource struct {
-	client *Client
+	client ClientInterface
 }
 
 type NetworkResourceResourceModel struct {
@@ -36,6 +34,7 @@ type NetworkResourceResourceModel struct {
 	Name        types.String `tfsdk:"name"`
 	Description types.String `tfsdk:"description"`
 	Address     types.String `tfsdk:"address"`
+	Type        types.String `tfsdk:"type"`
 	PeerGroups  types.List   `tfsdk:"peer_groups"`
 	Enabled     types.Bool   `tfsdk:"enabled"`
 }
@@ -76,6 +75,13 @@ func (r *NetworkResourceResource) Schema(ctx context.Context, req resource.Schem
 				MarkdownDescription: "Network resource address (either a direct host like 1.1.1.1 or 1.1.1.1/32, or a subnet like 192.168.178.0/24, or domains like example.com and *.example.com)",
 				Required:            true,
 			},
+			"type": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Type of the address as resolved by NetBird: `host`, `subnet` or `domain`",
+				PlanModifiers: []planmodifier.String{
+					stringUseStateUnlessChanged(path.Root("address")),
+				},
+			},
 			"peer_groups": schema.ListAttribute{
 				ElementType:         types.StringType,
 				MarkdownDescription: "Group IDs containing the resource",
@@ -89,13 +95,17 @@ func (r *NetworkResourceResource) Schema(ctx context.Context, req resource.Schem
 	}
 }
 
+func (r *NetworkResourceResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = networkChildIdentitySchema("network resource")
+}
+
 func (r *NetworkResourceResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	// Prevent panic if the provider has not been configured.
 	if req.ProviderData == nil {
 		return
 	}
 
-	client, ok := req.ProviderData.(*Client)
+	client, ok := req.ProviderData.(ClientInterface)
 
 	if !ok {
 		resp.Diagnostics.AddError(
@@ -129,38 +139,17 @@ func (r *NetworkResourceResource) Create(ctx context.Context, req resource.Creat
 		return
 	}
 
-	requestBody, err := json.Marshal(apiData)
-	if err != nil {
-		resp.Diagnostics.AddError("Error marshaling request body", err.Error())
-		return
-	}
-
 	// Make API request
-	reqURL := fmt.Sprintf("%s/api/networks/%s/resources", r.client.BaseUrl, data.NetworkId.ValueString())
-	httpReq, err := http.NewRequest("POST", reqURL, bytes.NewBuffer(requestBody))
-	if err != nil {
-		resp.Diagnostics.AddError("Error creating request", err.Error())
-		return
-	}
-	httpReq.Header.Set("Content-Type", "application/json")
-
-	responseBody, err := r.client.doRequest(httpReq)
+	responseData, err := postJSON[netbirdApi.NetworkResource](ctx, r.client, fmt.Sprintf("/api/networks/%s/resources", data.NetworkId.ValueString()), apiData)
 	if err != nil {
 		resp.Diagnostics.AddError("Error making API request", err.Error())
 		return
 	}
 
-	// Parse response
-	var responseData netbirdApi.NetworkResource
-	if err := json.Unmarshal(responseBody, &responseData); err != nil {
-		resp.Diagnostics.AddError("Error parsing response", err.Error())
-		return
-	}
-
 	// Assign values from API response
 	data.ID = types.StringValue(responseData.Id)
 
-	diags = r.readIntoModel(&data)
+	diags = r.readIntoModel(ctx, &data)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -168,6 +157,7 @@ func (r *NetworkResourceResource) Create(ctx context.Context, req resource.Creat
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setNetworkChildIdentity(ctx, resp.Identity, data.NetworkId, data.ID)...)
 }
 
 func (r *NetworkResourceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -180,47 +170,43 @@ func (r *NetworkResourceResource) Read(ctx context.Context, req resource.ReadReq
 		return
 	}
 
-	diags := r.readIntoModel(&data)
+	// The framework requires an identity even when the object has been deleted
+	resp.Diagnostics.Append(setNetworkChildIdentity(ctx, resp.Identity, data.NetworkId, data.ID)...)
+
+	diags := r.readIntoModel(ctx, &data)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	// The object has been deleted outside of Terraform
+	if data.ID.IsNull() {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
-func (r *NetworkResourceResource) readIntoModel(data *NetworkResourceResourceModel) diag.Diagnostics {
+func (r *NetworkResourceResource) readIntoModel(ctx context.Context, data *NetworkResourceResourceModel) diag.Diagnostics {
 	// Update network model
 	// Fetch data from API
 	diags := diag.Diagnostics{}
 	if data == nil {
 		return diags
 	}
-	reqURL := fmt.Sprintf("%s/api/networks/%s/resources/%s", r.client.BaseUrl, data.NetworkId.ValueString(), data.ID.ValueString())
-	httpReq, err := http.NewRequest("GET", reqURL, nil)
-	if err != nil {
-		diags.AddError("Error creating request", err.Error())
-		return diags
-	}
-
-	responseBody, err := r.client.doRequest(httpReq)
+	responseData, err := getJSON[netbirdApi.NetworkResource](ctx, r.client, fmt.Sprintf("/api/networks/%s/resources/%s", data.NetworkId.ValueString(), data.ID.ValueString()))
 	if err != nil {
 		diags.AddError("Error fetching network", err.Error())
 		return diags
 	}
 	// If not found
-	if responseBody == nil {
+	if responseData == nil {
 		data.ID = types.StringNull()
 		return diags
 	}
 
-	var responseData netbirdApi.NetworkResource
-	if err := json.Unmarshal(responseBody, &responseData); err != nil {
-		diags.AddError("Error parsing response", err.Error())
-		return diags
-	}
-
 	// Update state with latest data
 	data.Name = types.StringValue(responseData.Name)
 	data.Description = nullStringToEmptyString(derefString(responseData.Description))
@@ -231,6 +217,7 @@ func (r *NetworkResourceResource) readIntoModel(data *NetworkResourceResourceMod
 	data.PeerGroups = peerGroups
 
 	data.Address = types.StringValue(responseData.Address)
+	data.Type = types.StringValue(string(responseData.Type))
 	data.Enabled = types.BoolValue(responseData.Enabled)
 
 	return diags
@@ -273,27 +260,13 @@ func (r *NetworkResourceResource) Update(ctx context.Context, req resource.Updat
 		return
 	}
 
-	requestBody, err := json.Marshal(&apiData)
-	if err != nil {
-		resp.Diagnostics.AddError("Error marshaling request body", err.Error())
-		return
-	}
-
-	reqURL := fmt.Sprintf("%s/api/networks/%s/resources/%s", r.client.BaseUrl, data.NetworkId.ValueString(), data.ID.ValueString())
-	httpReq, err := http.NewRequest("PUT", reqURL, bytes.NewBuffer(requestBody))
-	if err != nil {
-		resp.Diagnostics.AddError("Error creating request", err.Error())
-		return
-	}
-	httpReq.Header.Set("Content-Type", "application/json")
-
-	_, err = r.client.doRequest(httpReq)
+	_, err := putJSON[netbirdApi.NetworkResource](ctx, r.client, fmt.Sprintf("/api/networks/%s/resources/%s", data.NetworkId.ValueString(), data.ID.ValueString()), apiData)
 	if err != nil {
 		resp.Diagnostics.AddError("Error updating network", err.Error())
 		return
 	}
 
-	diags = r.readIntoModel(&data)
+	diags = r.readIntoModel(ctx, &data)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -301,6 +274,7 @@ func (r *NetworkResourceResource) Update(ctx context.Context, req resource.Updat
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setNetworkChildIdentity(ctx, resp.Identity, data.NetworkId, data.ID)...)
 }
 
 func (r *NetworkResourceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -313,14 +287,7 @@ func (r *NetworkResourceResource) Delete(ctx context.Context, req resource.Delet
 		return
 	}
 
-	reqURL := fmt.Sprintf("%s/api/networks/%s/resources/%s", r.client.BaseUrl, data.NetworkId.ValueString(), data.ID.ValueString())
-	httpReq, err := http.NewRequest("DELETE", reqURL, nil)
-	if err != nil {
-		resp.Diagnostics.AddError("Error creating request", err.Error())
-		return
-	}
-
-	_, err = r.client.doRequest(httpReq)
+	err := deleteObject(ctx, r.client, fmt.Sprintf("/api/networks/%s/resources/%s", data.NetworkId.ValueString(), data.ID.ValueString()))
 	if err != nil {
 		resp.Diagnostics.AddError("Error deleting network", err.Error())
 		return
@@ -330,5 +297,5 @@ func (r *NetworkResourceResource) Delete(ctx context.Context, req resource.Delet
 }
 
 func (r *NetworkResourceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	importNetworkChildState(ctx, req, resp)
 }
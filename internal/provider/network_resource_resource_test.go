@@ -0,0 +1,90 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccNetworkResourceResource(t *testing.T) {
+	providerConfig, mock := testAccProviderConfig(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckDestroy(mock, "netbird_network_resource", networkChildPath("resources")),
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + testAccNetworkResourceResourceConfig("10.10.0.0/24", true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("netbird_network_resource.test", "name", "tf-acc-resource"),
+					resource.TestCheckResourceAttr("netbird_network_resource.test", "address", "10.10.0.0/24"),
+					resource.TestCheckResourceAttr("netbird_network_resource.test", "type", "subnet"),
+					resource.TestCheckResourceAttr("netbird_network_resource.test", "enabled", "true"),
+					resource.TestCheckResourceAttr("netbird_network_resource.test", "peer_groups.#", "1"),
+					resource.TestCheckResourceAttrPair("netbird_network_resource.test", "peer_groups.0", "netbird_group.test", "id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "netbird_network_resource.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccNetworkChildImportID("netbird_network_resource.test"),
+			},
+			// The type is only resolved again when the address changes
+			{
+				Config: providerConfig + testAccNetworkResourceResourceConfig("10.10.0.0/24", false),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectKnownValue("netbird_network_resource.test", tfjsonpath.New("type"), knownvalue.StringExact("subnet")),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("netbird_network_resource.test", "enabled", "false"),
+					resource.TestCheckResourceAttr("netbird_network_resource.test", "type", "subnet"),
+				),
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + testAccNetworkResourceResourceConfig("internal.example.com", false),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectUnknownValue("netbird_network_resource.test", tfjsonpath.New("type")),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("netbird_network_resource.test", "address", "internal.example.com"),
+					resource.TestCheckResourceAttr("netbird_network_resource.test", "type", "domain"),
+					resource.TestCheckResourceAttr("netbird_network_resource.test", "enabled", "false"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccNetworkResourceResourceConfig(address string, enabled bool) string {
+	return fmt.Sprintf(`
+resource "netbird_network" "test" {
+  name = "tf-acc-resource-network"
+}
+
+resource "netbird_group" "test" {
+  name = "tf-acc-resource-group"
+}
+
+resource "netbird_network_resource" "test" {
+  network_id  = netbird_network.test.id
+  name        = "tf-acc-resource"
+  address     = %q
+  peer_groups = [netbird_group.test.id]
+  enabled     = %t
+}
+`, address, enabled)
+}
@@ -0,0 +1,109 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+func TestAccNetworkResource(t *testing.T) {
+	providerConfig, mock := testAccProviderConfig(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckDestroy(mock, "netbird_network", staticPath("/api/networks")),
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + testAccNetworkResourceConfig("tf-acc-network", "Created by acceptance tests"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("netbird_network.test", "name", "tf-acc-network"),
+					resource.TestCheckResourceAttr("netbird_network.test", "description", "Created by acceptance tests"),
+					resource.TestCheckResourceAttr("netbird_network.test", "routing_peers_count", "0"),
+					resource.TestCheckResourceAttrSet("netbird_network.test", "id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "netbird_network.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + testAccNetworkResourceConfig("tf-acc-network-renamed", "Updated by acceptance tests"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("netbird_network.test", "name", "tf-acc-network-renamed"),
+					resource.TestCheckResourceAttr("netbird_network.test", "description", "Updated by acceptance tests"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccNetworkResourceConfig(name string, description string) string {
+	return fmt.Sprintf(`
+resource "netbird_network" "test" {
+  name        = %q
+  description = %q
+}
+`, name, description)
+}
+
+func TestAccNetworkResource_timeouts(t *testing.T) {
+	providerConfig, mock := testAccProviderConfig(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckDestroy(mock, "netbird_network", staticPath("/api/networks")),
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+resource "netbird_network" "test" {
+  name = "tf-acc-network-timeouts"
+
+  timeouts {
+    create = "2m"
+    delete = "30s"
+  }
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("netbird_network.test", "timeouts.create", "2m"),
+					resource.TestCheckResourceAttr("netbird_network.test", "timeouts.delete", "30s"),
+					resource.TestCheckNoResourceAttr("netbird_network.test", "timeouts.update"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccNetworkResource_disappears(t *testing.T) {
+	testAccMockOnly(t)
+	providerConfig, mock := testAccProviderConfig(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + testAccNetworkResourceConfig("tf-acc-disappears", ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDisappears(mock, "netbird_network.test", staticPath("/api/networks")),
+				),
+				// The deleted object is removed from state and planned for creation
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("netbird_network.test", plancheck.ResourceActionCreate),
+					},
+				},
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
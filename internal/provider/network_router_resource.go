@@ -1,17 +1,14 @@
 package provider
 
 import (
-	"bytes"
 	"context"
-	"encoding/json"
 	"fmt"
-	"net/http"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -21,6 +18,7 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &NetworkRouterResource{}
 var _ resource.ResourceWithImportState = &NetworkRouterResource{}
+var _ resource.ResourceWithIdentity = &NetworkRouterResource{}
 
 func NewNetworkRouterResource() resource.Resource {
 	return &NetworkRouterResource{}
@@ -28,7 +26,7 @@ func NewNetworkRouterResource() resource.Resource {
 
 // NetworkRouterResource defines the resource implementation.
 type NetworkRouterResource struct {
-	client *Client
+	client ClientInterface
 }
 
 type NetworkRouterResourceModel struct {
@@ -79,6 +77,9 @@ func (r *NetworkRouterResource) Schema(ctx context.Context, req resource.SchemaR
 				Optional:            true,
 				Default:             int32default.StaticInt32(999),
 				Computed:            true,
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.UseStateForUnknown(),
+				},
 			},
 			"masquerade": schema.BoolAttribute{
 				MarkdownDescription: "Indicate if peer should masquerade traffic to this route's prefix",
@@ -92,13 +93,17 @@ func (r *NetworkRouterResource) Schema(ctx context.Context, req resource.SchemaR
 	}
 }
 
+func (r *NetworkRouterResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = networkChildIdentitySchema("network router")
+}
+
 func (r *NetworkRouterResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	// Prevent panic if the provider has not been configured.
 	if req.ProviderData == nil {
 		return
 	}
 
-	client, ok := req.ProviderData.(*Client)
+	client, ok := req.ProviderData.(ClientInterface)
 
 	if !ok {
 		resp.Diagnostics.AddError(
@@ -132,38 +137,17 @@ func (r *NetworkRouterResource) Create(ctx context.Context, req resource.CreateR
 		return
 	}
 
-	requestBody, err := json.Marshal(apiData)
-	if err != nil {
-		resp.Diagnostics.AddError("Error marshaling request body", err.Error())
-		return
-	}
-
 	// Make API request
-	reqURL := fmt.Sprintf("%s/api/networks/%s/routers", r.client.BaseUrl, data.NetworkId.ValueString())
-	httpReq, err := http.NewRequest("POST", reqURL, bytes.NewBuffer(requestBody))
-	if err != nil {
-		resp.Diagnostics.AddError("Error creating request", err.Error())
-		return
-	}
-	httpReq.Header.Set("Content-Type", "application/json")
-
-	responseBody, err := r.client.doRequest(httpReq)
+	responseData, err := postJSON[netbirdApi.NetworkRouter](ctx, r.client, fmt.Sprintf("/api/networks/%s/routers", data.NetworkId.ValueString()), apiData)
 	if err != nil {
 		resp.Diagnostics.AddError("Error making API request", err.Error())
 		return
 	}
 
-	// Parse response
-	var responseData netbirdApi.NetworkRouter
-	if err := json.Unmarshal(responseBody, &responseData); err != nil {
-		resp.Diagnostics.AddError("Error parsing response", err.Error())
-		return
-	}
-
 	// Assign values from API response
 	data.ID = types.StringValue(responseData.Id)
 
-	diags = r.readNetworkRouterIntoModel(&data)
+	diags = r.readNetworkRouterIntoModel(ctx, &data)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -171,6 +155,7 @@ func (r *NetworkRouterResource) Create(ctx context.Context, req resource.CreateR
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setNetworkChildIdentity(ctx, resp.Identity, data.NetworkId, data.ID)...)
 }
 
 func (r *NetworkRouterResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -183,54 +168,50 @@ func (r *NetworkRouterResource) Read(ctx context.Context, req resource.ReadReque
 		return
 	}
 
-	diags := r.readNetworkRouterIntoModel(&data)
+	// The framework requires an identity even when the object has been deleted
+	resp.Diagnostics.Append(setNetworkChildIdentity(ctx, resp.Identity, data.NetworkId, data.ID)...)
+
+	diags := r.readNetworkRouterIntoModel(ctx, &data)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	// The object has been deleted outside of Terraform
+	if data.ID.IsNull() {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
-func (r *NetworkRouterResource) readNetworkRouterIntoModel(data *NetworkRouterResourceModel) diag.Diagnostics {
+func (r *NetworkRouterResource) readNetworkRouterIntoModel(ctx context.Context, data *NetworkRouterResourceModel) diag.Diagnostics {
 	// Update network model
 	// Fetch data from API
 	diags := diag.Diagnostics{}
 	if data == nil {
 		return diags
 	}
-	reqURL := fmt.Sprintf("%s/api/networks/%s/routers/%s", r.client.BaseUrl, data.NetworkId.ValueString(), data.ID.ValueString())
-	httpReq, err := http.NewRequest("GET", reqURL, nil)
-	if err != nil {
-		diags.AddError("Error creating request", err.Error())
-		return diags
-	}
-
-	responseBody, err := r.client.doRequest(httpReq)
+	responseData, err := getJSON[netbirdApi.NetworkRouter](ctx, r.client, fmt.Sprintf("/api/networks/%s/routers/%s", data.NetworkId.ValueString(), data.ID.ValueString()))
 	if err != nil {
 		diags.AddError("Error fetching network", err.Error())
 		return diags
 	}
 	// If not found
-	if responseBody == nil {
+	if responseData == nil {
 		data.ID = types.StringNull()
 		return diags
 	}
 
-	var responseData netbirdApi.NetworkRouter
-	if err := json.Unmarshal(responseBody, &responseData); err != nil {
-		diags.AddError("Error parsing response", err.Error())
-		return diags
-	}
-
 	// Update state with latest data
 	data.Peer = nullStringToEmptyString(derefString(responseData.Peer))
 	peerGroups, diags := convertStringSliceToListValue(derefStringSlice(responseData.PeerGroups))
 	if diags.HasError() {
 		return diags
 	}
-	data.PeerGroups = peerGroups
+	data.PeerGroups = preserveNullList(peerGroups, data.PeerGroups)
 
 	data.Metric = types.Int32Value(int32(responseData.Metric))
 	data.Enabled = types.BoolValue(responseData.Enabled)
@@ -276,27 +257,13 @@ func (r *NetworkRouterResource) Update(ctx context.Context, req resource.UpdateR
 		return
 	}
 
-	requestBody, err := json.Marshal(&apiData)
-	if err != nil {
-		resp.Diagnostics.AddError("Error marshaling request body", err.Error())
-		return
-	}
-
-	reqURL := fmt.Sprintf("%s/api/networks/%s/routers/%s", r.client.BaseUrl, data.NetworkId.ValueString(), data.ID.ValueString())
-	httpReq, err := http.NewRequest("PUT", reqURL, bytes.NewBuffer(requestBody))
-	if err != nil {
-		resp.Diagnostics.AddError("Error creating request", err.Error())
-		return
-	}
-	httpReq.Header.Set("Content-Type", "application/json")
-
-	_, err = r.client.doRequest(httpReq)
+	_, err := putJSON[netbirdApi.NetworkRouter](ctx, r.client, fmt.Sprintf("/api/networks/%s/routers/%s", data.NetworkId.ValueString(), data.ID.ValueString()), apiData)
 	if err != nil {
 		resp.Diagnostics.AddError("Error updating network", err.Error())
 		return
 	}
 
-	diags = r.readNetworkRouterIntoModel(&data)
+	diags = r.readNetworkRouterIntoModel(ctx, &data)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -304,6 +271,7 @@ func (r *NetworkRouterResource) Update(ctx context.Context, req resource.UpdateR
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setNetworkChildIdentity(ctx, resp.Identity, data.NetworkId, data.ID)...)
 }
 
 func (r *NetworkRouterResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -316,14 +284,7 @@ func (r *NetworkRouterResource) Delete(ctx context.Context, req resource.DeleteR
 		return
 	}
 
-	reqURL := fmt.Sprintf("%s/api/networks/%s/routers/%s", r.client.BaseUrl, data.NetworkId.ValueString(), data.ID.ValueString())
-	httpReq, err := http.NewRequest("DELETE", reqURL, nil)
-	if err != nil {
-		resp.Diagnostics.AddError("Error creating request", err.Error())
-		return
-	}
-
-	_, err = r.client.doRequest(httpReq)
+	err := deleteObject(ctx, r.client, fmt.Sprintf("/api/networks/%s/routers/%s", data.NetworkId.ValueString(), data.ID.ValueString()))
 	if err != nil {
 		resp.Diagnostics.AddError("Error deleting network", err.Error())
 		return
@@ -333,5 +294,5 @@ func (r *NetworkRouterResource) Delete(ctx context.Context, req resource.DeleteR
 }
 
 func (r *NetworkRouterResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	importNetworkChildState(ctx, req, resp)
 }
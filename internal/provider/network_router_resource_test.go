@@ -0,0 +1,190 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccNetworkRouterResource(t *testing.T) {
+	providerConfig, mock := testAccProviderConfig(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckDestroy(mock, "netbird_network_router", networkChildPath("routers")),
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + testAccNetworkRouterResourceConfig(100, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("netbird_network_router.test", "metric", "100"),
+					resource.TestCheckResourceAttr("netbird_network_router.test", "masquerade", "true"),
+					resource.TestCheckResourceAttr("netbird_network_router.test", "enabled", "true"),
+					resource.TestCheckResourceAttr("netbird_network_router.test", "peer_groups.#", "1"),
+					resource.TestCheckResourceAttrPair("netbird_network_router.test", "peer_groups.0", "netbird_group.test", "id"),
+					resource.TestCheckResourceAttrPair("netbird_network_router.test", "network_id", "netbird_network.test", "id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "netbird_network_router.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccNetworkChildImportID("netbird_network_router.test"),
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + testAccNetworkRouterResourceConfig(200, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("netbird_network_router.test", "metric", "200"),
+					resource.TestCheckResourceAttr("netbird_network_router.test", "masquerade", "false"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccNetworkRouterResource_defaultMetric(t *testing.T) {
+	providerConfig, mock := testAccProviderConfig(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckDestroy(mock, "netbird_network_router", networkChildPath("routers")),
+		Steps: []resource.TestStep{
+			// The default is applied on create
+			{
+				Config: providerConfig + testAccNetworkRouterResourceDefaultMetricConfig(""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("netbird_network_router.test", "metric", "999"),
+				),
+			},
+			// and kept on refresh without a diff
+			{
+				Config: providerConfig + testAccNetworkRouterResourceDefaultMetricConfig(""),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("netbird_network_router.test", "metric", "999"),
+				),
+			},
+			{
+				Config: providerConfig + testAccNetworkRouterResourceDefaultMetricConfig("metric = 10"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("netbird_network_router.test", "metric", "10"),
+				),
+			},
+			// Removing the metric restores the default
+			{
+				Config: providerConfig + testAccNetworkRouterResourceDefaultMetricConfig(""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("netbird_network_router.test", "metric", "999"),
+				),
+			},
+		},
+	})
+}
+
+// networkChildPath is a collectionPath for resources stored below a network.
+func networkChildPath(kind string) func(*terraform.ResourceState) string {
+	return func(rs *terraform.ResourceState) string {
+		return fmt.Sprintf("/api/networks/%s/%s", rs.Primary.Attributes["network_id"], kind)
+	}
+}
+
+// testAccNetworkChildImportID returns the "<network_id>/<id>" import ID of a
+// resource stored below a network.
+func testAccNetworkChildImportID(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("resource %s not found", resourceName)
+		}
+		return rs.Primary.Attributes["network_id"] + "/" + rs.Primary.ID, nil
+	}
+}
+
+func testAccNetworkRouterResourceConfig(metric int, masquerade bool) string {
+	return fmt.Sprintf(`
+resource "netbird_network" "test" {
+  name = "tf-acc-router-network"
+}
+
+resource "netbird_group" "test" {
+  name = "tf-acc-router-group"
+}
+
+resource "netbird_network_router" "test" {
+  network_id  = netbird_network.test.id
+  peer_groups = [netbird_group.test.id]
+  metric      = %d
+  masquerade  = %t
+  enabled     = true
+}
+`, metric, masquerade)
+}
+
+func testAccNetworkRouterResourceDefaultMetricConfig(metric string) string {
+	return fmt.Sprintf(`
+resource "netbird_network" "test" {
+  name = "tf-acc-router-network"
+}
+
+resource "netbird_group" "test" {
+  name = "tf-acc-router-group"
+}
+
+resource "netbird_network_router" "test" {
+  network_id  = netbird_network.test.id
+  peer_groups = [netbird_group.test.id]
+  masquerade  = true
+  enabled     = true
+  %s
+}
+`, metric)
+}
+
+func TestAccNetworkRouterResource_identity(t *testing.T) {
+	providerConfig, mock := testAccProviderConfig(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_12_0),
+		},
+		CheckDestroy: testAccCheckDestroy(mock, "netbird_network_router", networkChildPath("routers")),
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + testAccNetworkRouterResourceConfig(100, true),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectIdentityValueMatchesState("netbird_network_router.test", tfjsonpath.New("network_id")),
+					statecheck.ExpectIdentityValueMatchesState("netbird_network_router.test", tfjsonpath.New("id")),
+				},
+			},
+			// Both import formats resolve to the same router
+			{
+				ResourceName:    "netbird_network_router.test",
+				ImportState:     true,
+				ImportStateKind: resource.ImportBlockWithResourceIdentity,
+			},
+			{
+				ResourceName:      "netbird_network_router.test",
+				ImportState:       true,
+				ImportStateKind:   resource.ImportBlockWithID,
+				ImportStateIdFunc: testAccNetworkChildImportID("netbird_network_router.test"),
+			},
+		},
+	})
+}
@@ -0,0 +1,186 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	netbirdApi "github.com/netbirdio/netbird/management/server/http/api"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &PeerApprovalResource{}
+var _ resource.ResourceWithImportState = &PeerApprovalResource{}
+
+func NewPeerApprovalResource() resource.Resource {
+	return &PeerApprovalResource{}
+}
+
+// PeerApprovalResource approves a single peer that is pending approval.
+type PeerApprovalResource struct {
+	client ClientInterface
+}
+
+type PeerApprovalResourceModel struct {
+	ID              types.String `tfsdk:"id"`
+	PeerID          types.String `tfsdk:"peer_id"`
+	RevokeOnDestroy types.Bool   `tfsdk:"revoke_on_destroy"`
+}
+
+func (r *PeerApprovalResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_peer_approval"
+}
+
+func (r *PeerApprovalResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Approves a peer that is waiting for approval when `peer_approval_enabled` is set on the account (Cloud only). " +
+			"A peer that requires approval again outside of Terraform is approved on the next apply. " +
+			"Use `netbird_peer_batch_approval` to approve several peers at once.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "ID of the approved peer",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"peer_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "ID of the peer to approve",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					objectIDValidator,
+				},
+			},
+			"revoke_on_destroy": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Require approval of the peer again when the resource is destroyed. Set to `false` to leave the peer approved. Defaults to `true`",
+				Default:             booldefault.StaticBool(true),
+			},
+		},
+	}
+}
+
+func (r *PeerApprovalResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(ClientInterface)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *PeerApprovalResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data PeerApprovalResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(setPeerApprovalRequired(ctx, r.client, path.Root("peer_id"), []string{data.PeerID.ValueString()}, false, true)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = data.PeerID
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PeerApprovalResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data PeerApprovalResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	peer, err := getJSON[netbirdApi.Peer](ctx, r.client, fmt.Sprintf("/api/peers/%s", data.ID.ValueString()))
+	if err != nil {
+		resp.Diagnostics.AddError("Error fetching peer", err.Error())
+		return
+	}
+
+	// Forget peers that were deleted or require approval again, so the
+	// approval is recreated on the next apply
+	if peer == nil || peer.ApprovalRequired {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.PeerID = types.StringValue(peer.Id)
+	if data.RevokeOnDestroy.IsNull() {
+		data.RevokeOnDestroy = types.BoolValue(true)
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PeerApprovalResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data PeerApprovalResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only revoke_on_destroy can change without replacing the resource,
+	// which is used on destroy
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PeerApprovalResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data PeerApprovalResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.RevokeOnDestroy.ValueBool() {
+		resp.Diagnostics.Append(setPeerApprovalRequired(ctx, r.client, path.Root("peer_id"), []string{data.PeerID.ValueString()}, true, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *PeerApprovalResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -0,0 +1,97 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccPeerApprovalResource(t *testing.T) {
+	// Approving peers on a live account would let unknown machines connect
+	testAccMockOnly(t)
+	providerConfig, mock := testAccProviderConfig(t)
+	peerID := mock.Seed("/api/peers", map[string]any{"name": "tf-acc-pending", "approval_required": true})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		// Destroying the resource requires approval again
+		CheckDestroy: testAccCheckPeersApprovalRequired(mock, true, peerID),
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + testAccPeerApprovalResourceConfig(peerID, ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("netbird_peer_approval.test", "id", peerID),
+					resource.TestCheckResourceAttr("netbird_peer_approval.test", "revoke_on_destroy", "true"),
+					testAccCheckPeersApprovalRequired(mock, false, peerID),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "netbird_peer_approval.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// A peer that requires approval again outside of Terraform is approved again
+			{
+				PreConfig: func() {
+					mock.Seed("/api/peers", map[string]any{"id": peerID, "name": "tf-acc-pending", "approval_required": true})
+				},
+				Config: providerConfig + testAccPeerApprovalResourceConfig(peerID, ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPeersApprovalRequired(mock, false, peerID),
+				),
+			},
+		},
+	})
+}
+
+func TestAccPeerApprovalResource_keepOnDestroy(t *testing.T) {
+	testAccMockOnly(t)
+	providerConfig, mock := testAccProviderConfig(t)
+	peerID := mock.Seed("/api/peers", map[string]any{"name": "tf-acc-pending", "approval_required": true})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		// The peer stays approved after the resource is destroyed
+		CheckDestroy: testAccCheckPeersApprovalRequired(mock, false, peerID),
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + testAccPeerApprovalResourceConfig(peerID, "revoke_on_destroy = false"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("netbird_peer_approval.test", "revoke_on_destroy", "false"),
+					testAccCheckPeersApprovalRequired(mock, false, peerID),
+				),
+			},
+		},
+	})
+}
+
+func TestAccPeerApprovalResource_unknownPeer(t *testing.T) {
+	testAccMockOnly(t)
+	providerConfig, _ := testAccProviderConfig(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      providerConfig + testAccPeerApprovalResourceConfig("tf-acc-missing-peer", ""),
+				ExpectError: regexp.MustCompile(`No peer exists with ID "tf-acc-missing-peer"`),
+			},
+		},
+	})
+}
+
+func testAccPeerApprovalResourceConfig(peerID string, extra string) string {
+	return fmt.Sprintf(`
+resource "netbird_peer_approval" "test" {
+  peer_id = %q
+  %s
+}
+`, peerID, extra)
+}
@@ -0,0 +1,249 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	netbirdApi "github.com/netbirdio/netbird/management/server/http/api"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &PeerBatchApprovalResource{}
+
+func NewPeerBatchApprovalResource() resource.Resource {
+	return &PeerBatchApprovalResource{}
+}
+
+// PeerBatchApprovalResource approves a list of peers that are pending approval.
+type PeerBatchApprovalResource struct {
+	client ClientInterface
+}
+
+type PeerBatchApprovalResourceModel struct {
+	ID      types.String `tfsdk:"id"`
+	PeerIDs types.List   `tfsdk:"peer_ids"`
+}
+
+func (r *PeerBatchApprovalResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_peer_batch_approval"
+}
+
+func (r *PeerBatchApprovalResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Approves peers that are waiting for approval when `peer_approval_enabled` is set on the account (Cloud only). " +
+			"Peers removed from `peer_ids`, and all peers when the resource is destroyed, require approval again. " +
+			"A peer that requires approval again outside of Terraform is approved on the next apply.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Comma-separated IDs of the approved peers",
+			},
+			"peer_ids": schema.ListAttribute{
+				MarkdownDescription: "IDs of the peers to approve",
+				Required:            true,
+				ElementType:         types.StringType,
+			},
+		},
+	}
+}
+
+func (r *PeerBatchApprovalResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(ClientInterface)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// setPeerApprovalRequired writes each peer back with only approval_required
+// changed. Peers that no longer exist are skipped unless mustExist is set, in
+// which case the error is reported on attributePath.
+func setPeerApprovalRequired(ctx context.Context, client ClientInterface, attributePath path.Path, peerIDs []string, approvalRequired, mustExist bool) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+	for _, peerID := range peerIDs {
+		endpoint := fmt.Sprintf("/api/peers/%s", peerID)
+		peer, err := getJSON[netbirdApi.Peer](ctx, client, endpoint)
+		if err != nil {
+			diags.AddError("Error fetching peer", err.Error())
+			return diags
+		}
+		if peer == nil {
+			if mustExist {
+				diags.AddAttributeError(
+					attributePath,
+					"Peer not found",
+					fmt.Sprintf("No peer exists with ID %q.", peerID),
+				)
+				return diags
+			}
+			continue
+		}
+
+		// Skip the update when the peer already has the requested state
+		if peer.ApprovalRequired == approvalRequired {
+			continue
+		}
+		_, err = putJSON[netbirdApi.Peer](ctx, client, endpoint, netbirdApi.PeerRequest{
+			Name:                        peer.Name,
+			SshEnabled:                  peer.SshEnabled,
+			LoginExpirationEnabled:      peer.LoginExpirationEnabled,
+			InactivityExpirationEnabled: peer.InactivityExpirationEnabled,
+			ApprovalRequired:            &approvalRequired,
+		})
+		if err != nil {
+			diags.AddError("Error updating peer", err.Error())
+			return diags
+		}
+	}
+	return diags
+}
+
+func (r *PeerBatchApprovalResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data PeerBatchApprovalResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	peerIDs, diags := convertListToStringSlice(data.PeerIDs)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(setPeerApprovalRequired(ctx, r.client, path.Root("peer_ids"), peerIDs, false, true)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = types.StringValue(strings.Join(peerIDs, ","))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PeerBatchApprovalResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data PeerBatchApprovalResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	peerIDs, diags := convertListToStringSlice(data.PeerIDs)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only keep peers that exist and are still approved, so the others are
+	// approved again on the next apply
+	approved := []string{}
+	for _, peerID := range peerIDs {
+		peer, err := getJSON[netbirdApi.Peer](ctx, r.client, fmt.Sprintf("/api/peers/%s", peerID))
+		if err != nil {
+			resp.Diagnostics.AddError("Error fetching peer", err.Error())
+			return
+		}
+		if peer != nil && !peer.ApprovalRequired {
+			approved = append(approved, peerID)
+		}
+	}
+
+	data.PeerIDs, diags = convertStringSliceToListValue(approved)
+	resp.Diagnostics.Append(diags...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PeerBatchApprovalResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state PeerBatchApprovalResourceModel
+
+	// Read Terraform plan and prior state data into the models
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	peerIDs, diags := convertListToStringSlice(data.PeerIDs)
+	resp.Diagnostics.Append(diags...)
+	priorPeerIDs, diags := convertListToStringSlice(state.PeerIDs)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var removed []string
+	for _, peerID := range priorPeerIDs {
+		if !slices.Contains(peerIDs, peerID) {
+			removed = append(removed, peerID)
+		}
+	}
+
+	resp.Diagnostics.Append(setPeerApprovalRequired(ctx, r.client, path.Root("peer_ids"), removed, true, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(setPeerApprovalRequired(ctx, r.client, path.Root("peer_ids"), peerIDs, false, true)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = types.StringValue(strings.Join(peerIDs, ","))
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PeerBatchApprovalResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data PeerBatchApprovalResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	peerIDs, diags := convertListToStringSlice(data.PeerIDs)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(setPeerApprovalRequired(ctx, r.client, path.Root("peer_ids"), peerIDs, true, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
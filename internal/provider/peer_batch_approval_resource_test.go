@@ -0,0 +1,104 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/matthewjohn/terraform-provider-netbird/internal/testutils"
+)
+
+func TestAccPeerBatchApprovalResource(t *testing.T) {
+	// Approving peers on a live account would let unknown machines connect
+	testAccMockOnly(t)
+	providerConfig, mock := testAccProviderConfig(t)
+	first := mock.Seed("/api/peers", map[string]any{"name": "tf-acc-pending-1", "approval_required": true})
+	second := mock.Seed("/api/peers", map[string]any{"name": "tf-acc-pending-2", "approval_required": true})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		// Destroying the resource requires approval for all peers again
+		CheckDestroy: testAccCheckPeersApprovalRequired(mock, true, first, second),
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + testAccPeerBatchApprovalResourceConfig(first, second),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("netbird_peer_batch_approval.test", "peer_ids.#", "2"),
+					resource.TestCheckResourceAttr("netbird_peer_batch_approval.test", "id", first+","+second),
+					testAccCheckPeersApprovalRequired(mock, false, first, second),
+				),
+			},
+			// A peer that requires approval again outside of Terraform is approved again
+			{
+				PreConfig: func() {
+					mock.Seed("/api/peers", map[string]any{"id": second, "name": "tf-acc-pending-2", "approval_required": true})
+				},
+				Config: providerConfig + testAccPeerBatchApprovalResourceConfig(first, second),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPeersApprovalRequired(mock, false, first, second),
+				),
+			},
+			// Peers removed from the list require approval again
+			{
+				Config: providerConfig + testAccPeerBatchApprovalResourceConfig(first),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("netbird_peer_batch_approval.test", "peer_ids.#", "1"),
+					testAccCheckPeersApprovalRequired(mock, false, first),
+					testAccCheckPeersApprovalRequired(mock, true, second),
+				),
+			},
+		},
+	})
+}
+
+func TestAccPeerBatchApprovalResource_unknownPeer(t *testing.T) {
+	testAccMockOnly(t)
+	providerConfig, _ := testAccProviderConfig(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      providerConfig + testAccPeerBatchApprovalResourceConfig("tf-acc-missing-peer"),
+				ExpectError: regexp.MustCompile(`No peer exists with ID "tf-acc-missing-peer"`),
+			},
+		},
+	})
+}
+
+// testAccCheckPeersApprovalRequired checks the approval_required flag of the
+// peers stored by the mock API.
+func testAccCheckPeersApprovalRequired(mock *testutils.MockServer, approvalRequired bool, peerIDs ...string) resource.TestCheckFunc {
+	return func(*terraform.State) error {
+		for _, peerID := range peerIDs {
+			peer := mock.Get("/api/peers", peerID)
+			if peer == nil {
+				return fmt.Errorf("peer %s not found", peerID)
+			}
+			if got, _ := peer["approval_required"].(bool); got != approvalRequired {
+				return fmt.Errorf("expected peer %s approval_required to be %t, got %t", peerID, approvalRequired, got)
+			}
+		}
+		return nil
+	}
+}
+
+func testAccPeerBatchApprovalResourceConfig(peerIDs ...string) string {
+	ids := ""
+	for i, peerID := range peerIDs {
+		if i > 0 {
+			ids += ", "
+		}
+		ids += fmt.Sprintf("%q", peerID)
+	}
+	return fmt.Sprintf(`
+resource "netbird_peer_batch_approval" "test" {
+  peer_ids = [%s]
+}
+`, ids)
+}
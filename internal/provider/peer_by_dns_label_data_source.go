@@ -0,0 +1,131 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &PeerByDNSLabelDataSource{}
+
+func NewPeerByDNSLabelDataSource() datasource.DataSource {
+	return &PeerByDNSLabelDataSource{}
+}
+
+// PeerByDNSLabelDataSource defines the data source implementation.
+type PeerByDNSLabelDataSource struct {
+	client ClientInterface
+}
+
+func (d *PeerByDNSLabelDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_peer_by_dns_label"
+}
+
+func (d *PeerByDNSLabelDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Retrieve peer details by DNS label. Fails if no peer or more than one peer has the label.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Unique identifier of the peer.",
+			},
+		},
+	}
+	for name, attribute := range peerDataSourceAttributes() {
+		resp.Schema.Attributes[name] = attribute
+	}
+	resp.Schema.Attributes["dns_label"] = schema.StringAttribute{
+		Required:            true,
+		MarkdownDescription: "DNS label of the peer, either the full domain name, e.g. `my-peer.netbird.cloud`, or only the label, e.g. `my-peer`. Matching is case-insensitive.",
+	}
+}
+
+func (d *PeerByDNSLabelDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(ClientInterface)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *PeerByDNSLabelDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data PeerDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The API can't filter by DNS label, so filter client-side
+	peerList, err := getAllPages[peerWithRegistration](ctx, d.client, "/api/peers")
+	if err != nil {
+		resp.Diagnostics.AddError("Error Making API Request", err.Error())
+		return
+	}
+
+	dnsLabel := data.DNSLabel.ValueString()
+	var matches []peerWithRegistration
+	for _, peer := range peerList {
+		if dnsLabelMatches(peer.DnsLabel, dnsLabel) {
+			matches = append(matches, peer)
+		}
+	}
+
+	if len(matches) == 0 {
+		resp.Diagnostics.AddError("Peer Not Found", fmt.Sprintf("No peer with DNS label %q was found.", dnsLabel))
+		return
+	}
+	if len(matches) > 1 {
+		resp.Diagnostics.AddError(
+			"Multiple Peers Found",
+			fmt.Sprintf("%d peers match the DNS label %q. Use the full domain name or reference the peer by ID.", len(matches), dnsLabel),
+		)
+		return
+	}
+
+	// Keep the label as configured, which may omit the domain
+	data = convertPeerToDataSourceModel(matches[0])
+	data.DNSLabel = types.StringValue(dnsLabel)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// dnsLabelMatches reports whether the DNS label of a peer, which the API returns as a
+// fully qualified name, matches the requested label. A label without a domain matches
+// the first part of the peer's name.
+func dnsLabelMatches(peerLabel, label string) bool {
+	peerLabel = strings.TrimSuffix(peerLabel, ".")
+	label = strings.TrimSuffix(label, ".")
+	if label == "" {
+		return false
+	}
+	if strings.EqualFold(peerLabel, label) {
+		return true
+	}
+	if strings.Contains(label, ".") {
+		return false
+	}
+	host, _, _ := strings.Cut(peerLabel, ".")
+	return strings.EqualFold(host, label)
+}
@@ -0,0 +1,76 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	netbirdApi "github.com/netbirdio/netbird/management/server/http/api"
+)
+
+func TestAccPeerByDNSLabelDataSource(t *testing.T) {
+	testAccMockOnly(t)
+	providerConfig, mock := testAccProviderConfig(t)
+	peerID := mock.Seed("/api/peers", netbirdApi.PeerBatch{
+		Name:     "tf-acc-dns-peer",
+		Ip:       "100.64.0.20",
+		DnsLabel: "tf-acc-dns-peer.netbird.cloud",
+		Os:       "linux",
+	})
+	mock.Seed("/api/peers", netbirdApi.PeerBatch{
+		Name:     "tf-acc-other-peer",
+		DnsLabel: "tf-acc-other-peer.netbird.cloud",
+	})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+data "netbird_peer_by_dns_label" "fqdn" {
+  dns_label = "tf-acc-dns-peer.netbird.cloud"
+}
+
+data "netbird_peer_by_dns_label" "label" {
+  dns_label = "TF-ACC-DNS-PEER"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.netbird_peer_by_dns_label.fqdn", "id", peerID),
+					resource.TestCheckResourceAttr("data.netbird_peer_by_dns_label.fqdn", "name", "tf-acc-dns-peer"),
+					resource.TestCheckResourceAttr("data.netbird_peer_by_dns_label.fqdn", "ip", "100.64.0.20"),
+					resource.TestCheckResourceAttr("data.netbird_peer_by_dns_label.fqdn", "os", "linux"),
+					resource.TestCheckResourceAttr("data.netbird_peer_by_dns_label.label", "id", peerID),
+					resource.TestCheckResourceAttr("data.netbird_peer_by_dns_label.label", "dns_label", "TF-ACC-DNS-PEER"),
+				),
+			},
+			{
+				Config: providerConfig + `
+data "netbird_peer_by_dns_label" "missing" {
+  dns_label = "tf-acc-missing-peer"
+}
+`,
+				ExpectError: regexp.MustCompile(`No peer with DNS label "tf-acc-missing-peer" was found`),
+			},
+		},
+	})
+}
+
+func TestDNSLabelMatches(t *testing.T) {
+	for _, tc := range []struct {
+		peerLabel, label string
+		want             bool
+	}{
+		{"my-peer.netbird.cloud", "my-peer.netbird.cloud", true},
+		{"my-peer.netbird.cloud", "My-Peer.NetBird.Cloud.", true},
+		{"my-peer.netbird.cloud", "my-peer", true},
+		{"my-peer.netbird.cloud", "my-peer.example.com", false},
+		{"my-peer-2.netbird.cloud", "my-peer", false},
+		{"my-peer.netbird.cloud", "", false},
+	} {
+		if got := dnsLabelMatches(tc.peerLabel, tc.label); got != tc.want {
+			t.Errorf("dnsLabelMatches(%q, %q) = %v, want %v", tc.peerLabel, tc.label, got, tc.want)
+		}
+	}
+}
@@ -0,0 +1,91 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	netbirdApi "github.com/netbirdio/netbird/management/server/http/api"
+)
+
+func TestAccPeerDataSource(t *testing.T) {
+	testAccMockOnly(t)
+	providerConfig, mock := testAccProviderConfig(t)
+	peerID := mock.Seed("/api/peers", netbirdApi.PeerBatch{
+		Name:                        "tf-acc-peer",
+		Ip:                          "100.64.0.10",
+		DnsLabel:                    "tf-acc-peer.netbird.cloud",
+		Os:                          "linux",
+		Version:                     "0.43.0",
+		CountryCode:                 "GB",
+		InactivityExpirationEnabled: true,
+	})
+	// Registration dates are only returned by newer management servers
+	registeredPeerID := mock.Seed("/api/peers", map[string]any{
+		"name":       "tf-acc-registered-peer",
+		"user_id":    "tf-acc-user",
+		"created_at": "2025-03-01T12:00:00Z",
+		"last_seen":  "2025-03-02T08:30:00+01:00",
+		"last_login": "2025-03-01T12:05:00Z",
+	})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + fmt.Sprintf(`
+data "netbird_peer" "test" {
+  id = %q
+}
+
+data "netbird_peer" "registered" {
+  id = %q
+}
+`, peerID, registeredPeerID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.netbird_peer.test", "name", "tf-acc-peer"),
+					resource.TestCheckResourceAttr("data.netbird_peer.test", "ip", "100.64.0.10"),
+					resource.TestCheckResourceAttr("data.netbird_peer.test", "dns_label", "tf-acc-peer.netbird.cloud"),
+					resource.TestCheckResourceAttr("data.netbird_peer.test", "country_code", "GB"),
+					resource.TestCheckResourceAttr("data.netbird_peer.test", "inactivity_expiration_enabled", "true"),
+					resource.TestCheckResourceAttr("data.netbird_peer.registered", "inactivity_expiration_enabled", "false"),
+					resource.TestCheckNoResourceAttr("data.netbird_peer.test", "registered_at"),
+					resource.TestCheckResourceAttr("data.netbird_peer.registered", "registered_at", "2025-03-01T12:00:00Z"),
+					// Timestamps are RFC 3339 in UTC, the zero time the API returns for never is null
+					resource.TestCheckResourceAttr("data.netbird_peer.registered", "last_seen", "2025-03-02T07:30:00Z"),
+					resource.TestCheckResourceAttr("data.netbird_peer.registered", "last_seen_unix", "1740900600"),
+					resource.TestCheckResourceAttr("data.netbird_peer.registered", "last_login", "2025-03-01T12:05:00Z"),
+					resource.TestCheckResourceAttr("data.netbird_peer.registered", "last_login_unix", "1740830700"),
+					resource.TestCheckNoResourceAttr("data.netbird_peer.test", "last_login"),
+					resource.TestCheckNoResourceAttr("data.netbird_peer.test", "last_login_unix"),
+					resource.TestCheckResourceAttr("data.netbird_peer.test", "issued_by", "setup_key"),
+					resource.TestCheckResourceAttr("data.netbird_peer.registered", "issued_by", "user"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccPeerDataSource_invalidID(t *testing.T) {
+	providerConfig, _ := testAccProviderConfig(t)
+
+	steps := []resource.TestStep{}
+	for _, id := range []string{"", "peer 1", "../groups"} {
+		steps = append(steps, resource.TestStep{
+			Config: providerConfig + fmt.Sprintf(`
+data "netbird_peer" "test" {
+  id = %q
+}
+`, id),
+			ExpectError: regexp.MustCompile("must be a non-empty ID of letters, digits"),
+		})
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps:                    steps,
+	})
+}
@@ -0,0 +1,84 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	netbirdApi "github.com/netbirdio/netbird/management/server/http/api"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ list.ListResource = &PeerListResource{}
+var _ list.ListResourceWithConfigure = &PeerListResource{}
+
+func NewPeerListResource() list.ListResource {
+	return &PeerListResource{}
+}
+
+// PeerListResource lists peers so they can be found by `terraform query`.
+type PeerListResource struct {
+	client ClientInterface
+}
+
+func (r *PeerListResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_peer"
+}
+
+func (r *PeerListResource) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = listschema.Schema{
+		MarkdownDescription: "Lists peers, e.g. to generate import blocks for the whole fleet.",
+	}
+}
+
+func (r *PeerListResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(ClientInterface)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *PeerListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	// The listed peers have all fields of a single peer
+	peers, err := getAllPages[netbirdApi.Peer](ctx, r.client, "/api/peers")
+	if err != nil {
+		var diags diag.Diagnostics
+		diags.AddError("Error Making API Request", err.Error())
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		for _, peer := range peers {
+			result := req.NewListResult(ctx)
+			result.DisplayName = peer.Name
+			result.Diagnostics.Append(setIDIdentity(ctx, result.Identity, types.StringValue(peer.Id))...)
+			if req.IncludeResource {
+				var model PeerResourceModel
+				peerIntoModel(peer, &model)
+				result.Diagnostics.Append(result.Resource.Set(ctx, &model)...)
+			}
+
+			if !push(result) {
+				return
+			}
+		}
+	}
+}
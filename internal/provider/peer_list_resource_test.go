@@ -0,0 +1,67 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck/queryfilter"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccPeerListResource(t *testing.T) {
+	testAccMockOnly(t)
+	providerConfig, mock := testAccProviderConfig(t)
+	laptopID := mock.Seed("/api/peers", map[string]any{"name": "tf-acc-laptop", "user_id": "tf-acc-user", "ssh_enabled": true})
+	serverID := mock.Seed("/api/peers", map[string]any{"name": "tf-acc-server"})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Query: true,
+				Config: providerConfig + `
+list "netbird_peer" "all" {
+  provider = netbird
+}
+`,
+				QueryResultChecks: []querycheck.QueryResultCheck{
+					querycheck.ExpectLength("netbird_peer.all", 2),
+					querycheck.ExpectIdentity("netbird_peer.all", map[string]knownvalue.Check{
+						"id": knownvalue.StringExact(serverID),
+					}),
+					querycheck.ExpectResourceDisplayName("netbird_peer.all", queryfilter.ByResourceIdentity(map[string]knownvalue.Check{
+						"id": knownvalue.StringExact(serverID),
+					}), knownvalue.StringExact("tf-acc-server")),
+				},
+			},
+			{
+				Query: true,
+				Config: providerConfig + `
+list "netbird_peer" "all" {
+  provider         = netbird
+  include_resource = true
+}
+`,
+				QueryResultChecks: []querycheck.QueryResultCheck{
+					querycheck.ExpectResourceDisplayName("netbird_peer.all", queryfilter.ByResourceIdentity(map[string]knownvalue.Check{
+						"id": knownvalue.StringExact(laptopID),
+					}), knownvalue.StringExact("tf-acc-laptop")),
+					querycheck.ExpectResourceKnownValues("netbird_peer.all", queryfilter.ByResourceIdentity(map[string]knownvalue.Check{
+						"id": knownvalue.StringExact(laptopID),
+					}), []querycheck.KnownValueCheck{
+						{Path: tfjsonpath.New("name"), KnownValue: knownvalue.StringExact("tf-acc-laptop")},
+						{Path: tfjsonpath.New("ssh_enabled"), KnownValue: knownvalue.Bool(true)},
+						{Path: tfjsonpath.New("issued_by"), KnownValue: knownvalue.StringExact("user")},
+					}),
+				},
+			},
+		},
+	})
+}
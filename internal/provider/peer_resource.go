@@ -0,0 +1,282 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	netbirdApi "github.com/netbirdio/netbird/management/server/http/api"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &PeerResource{}
+var _ resource.ResourceWithImportState = &PeerResource{}
+var _ resource.ResourceWithIdentity = &PeerResource{}
+
+func NewPeerResource() resource.Resource {
+	return &PeerResource{}
+}
+
+// PeerResource manages the settings of an existing peer. Peers register
+// themselves, so the resource adopts them instead of creating them.
+type PeerResource struct {
+	client ClientInterface
+}
+
+type PeerResourceModel struct {
+	ID                          types.String `tfsdk:"id"`
+	Name                        types.String `tfsdk:"name"`
+	SshEnabled                  types.Bool   `tfsdk:"ssh_enabled"`
+	LoginExpirationEnabled      types.Bool   `tfsdk:"login_expiration_enabled"`
+	InactivityExpirationEnabled types.Bool   `tfsdk:"inactivity_expiration_enabled"`
+	IP                          types.String `tfsdk:"ip"`
+	DNSLabel                    types.String `tfsdk:"dns_label"`
+	IssuedBy                    types.String `tfsdk:"issued_by"`
+}
+
+func (r *PeerResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_peer"
+}
+
+func (r *PeerResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Manages the settings of a peer. Peers are added by registering a client, so creating the resource adopts an existing peer: " +
+			"attributes that are not set keep their current value, and destroying the resource only removes it from the Terraform state.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "ID of the peer",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					objectIDValidator,
+				},
+			},
+			"name": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Peer name",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"ssh_enabled":              optionalBool("Enables or disables the SSH server of the peer"),
+			"login_expiration_enabled": optionalBool("Expire the login of the peer after the account's `peer_login_expiration`"),
+			"inactivity_expiration_enabled": optionalBool("Expire the session of the peer after the account's `peer_inactivity_expiration`, " +
+				"overriding `peer_inactivity_expiration_enabled` of the account settings. When not set, the peer keeps the value it got from the account default"),
+			"ip": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "NetBird IP address of the peer",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"dns_label": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "DNS label of the peer",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"issued_by": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: peerIssuedByDescription,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *PeerResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentitySchema("peer")
+}
+
+func (r *PeerResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(ClientInterface)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// peerIntoModel sets the attributes of data read from the API to those of peer.
+func peerIntoModel(peer netbirdApi.Peer, data *PeerResourceModel) {
+	data.ID = types.StringValue(peer.Id)
+	data.Name = types.StringValue(peer.Name)
+	data.SshEnabled = types.BoolValue(peer.SshEnabled)
+	data.LoginExpirationEnabled = types.BoolValue(peer.LoginExpirationEnabled)
+	data.InactivityExpirationEnabled = types.BoolValue(peer.InactivityExpirationEnabled)
+	data.IP = types.StringValue(peer.Ip)
+	data.DNSLabel = types.StringValue(peer.DnsLabel)
+	data.IssuedBy = peerIssuedBy(peer.UserId)
+}
+
+// updatePeer applies the known attributes of data on top of the current
+// settings of the peer and reads the result back into data.
+func (r *PeerResource) updatePeer(ctx context.Context, data *PeerResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	endpoint := fmt.Sprintf("/api/peers/%s", data.ID.ValueString())
+	peer, err := getJSON[netbirdApi.Peer](ctx, r.client, endpoint)
+	if err != nil {
+		diags.AddError("Error fetching peer", err.Error())
+		return diags
+	}
+	if peer == nil {
+		diags.AddAttributeError(
+			path.Root("id"),
+			"Peer not found",
+			fmt.Sprintf("No peer exists with ID %q. Peers are added by registering a client and can not be created by Terraform.", data.ID.ValueString()),
+		)
+		return diags
+	}
+
+	request := netbirdApi.PeerRequest{
+		Name:                        peer.Name,
+		SshEnabled:                  peer.SshEnabled,
+		LoginExpirationEnabled:      peer.LoginExpirationEnabled,
+		InactivityExpirationEnabled: peer.InactivityExpirationEnabled,
+	}
+	if !data.Name.IsUnknown() && !data.Name.IsNull() {
+		request.Name = data.Name.ValueString()
+	}
+	if !data.SshEnabled.IsUnknown() && !data.SshEnabled.IsNull() {
+		request.SshEnabled = data.SshEnabled.ValueBool()
+	}
+	if !data.LoginExpirationEnabled.IsUnknown() && !data.LoginExpirationEnabled.IsNull() {
+		request.LoginExpirationEnabled = data.LoginExpirationEnabled.ValueBool()
+	}
+	if !data.InactivityExpirationEnabled.IsUnknown() && !data.InactivityExpirationEnabled.IsNull() {
+		request.InactivityExpirationEnabled = data.InactivityExpirationEnabled.ValueBool()
+	}
+
+	// Skip the update when the peer already has the requested settings
+	if request.Name != peer.Name || request.SshEnabled != peer.SshEnabled ||
+		request.LoginExpirationEnabled != peer.LoginExpirationEnabled ||
+		request.InactivityExpirationEnabled != peer.InactivityExpirationEnabled {
+		responseData, err := putJSON[netbirdApi.Peer](ctx, r.client, endpoint, request)
+		if err != nil {
+			diags.AddError("Error updating peer", err.Error())
+			return diags
+		}
+		if responseData == nil {
+			responseData, err = getJSON[netbirdApi.Peer](ctx, r.client, endpoint)
+			if err != nil {
+				diags.AddError("Error fetching peer", err.Error())
+				return diags
+			}
+			if responseData == nil {
+				diags.AddError("Error updating peer", fmt.Sprintf("Peer %s was removed while it was updated", data.ID.ValueString()))
+				return diags
+			}
+		}
+		peer = responseData
+	}
+
+	peerIntoModel(*peer, data)
+	return diags
+}
+
+func (r *PeerResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data PeerResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.updatePeer(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIDIdentity(ctx, resp.Identity, data.ID)...)
+}
+
+func (r *PeerResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data PeerResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The framework requires an identity even when the object has been deleted
+	resp.Diagnostics.Append(setIDIdentity(ctx, resp.Identity, data.ID)...)
+
+	peer, err := getJSON[netbirdApi.Peer](ctx, r.client, fmt.Sprintf("/api/peers/%s", data.ID.ValueString()))
+	if err != nil {
+		resp.Diagnostics.AddError("Error fetching peer", err.Error())
+		return
+	}
+
+	// The peer has been deleted outside of Terraform
+	if peer == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	peerIntoModel(*peer, &data)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PeerResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data PeerResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.updatePeer(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIDIdentity(ctx, resp.Identity, data.ID)...)
+}
+
+func (r *PeerResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Peers are removed by deleting the client registration, so the peer is
+	// left as it is.
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *PeerResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
+}
@@ -0,0 +1,167 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/matthewjohn/terraform-provider-netbird/internal/testutils"
+)
+
+func TestAccPeerResource(t *testing.T) {
+	// Peers can only be added by registering a client
+	testAccMockOnly(t)
+	providerConfig, mock := testAccProviderConfig(t)
+	peerID := mock.Seed("/api/peers", map[string]any{
+		"name":                          "tf-acc-peer",
+		"ip":                            "100.64.0.10",
+		"dns_label":                     "tf-acc-peer.netbird.cloud",
+		"ssh_enabled":                   false,
+		"login_expiration_enabled":      true,
+		"inactivity_expiration_enabled": false,
+	})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		// Destroying the resource leaves the peer registered
+		CheckDestroy: testAccCheckPeerSetting(mock, peerID, "inactivity_expiration_enabled", false),
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + testAccPeerResourceConfig(peerID, "inactivity_expiration_enabled = true"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("netbird_peer.test", "id", peerID),
+					resource.TestCheckResourceAttr("netbird_peer.test", "name", "tf-acc-peer"),
+					resource.TestCheckResourceAttr("netbird_peer.test", "ssh_enabled", "false"),
+					resource.TestCheckResourceAttr("netbird_peer.test", "login_expiration_enabled", "true"),
+					resource.TestCheckResourceAttr("netbird_peer.test", "inactivity_expiration_enabled", "true"),
+					resource.TestCheckResourceAttr("netbird_peer.test", "ip", "100.64.0.10"),
+					resource.TestCheckResourceAttr("netbird_peer.test", "dns_label", "tf-acc-peer.netbird.cloud"),
+					resource.TestCheckResourceAttr("netbird_peer.test", "issued_by", "setup_key"),
+					testAccCheckPeerSetting(mock, peerID, "inactivity_expiration_enabled", true),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "netbird_peer.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + testAccPeerResourceConfig(peerID, `
+  name                          = "tf-acc-renamed"
+  ssh_enabled                   = true
+  inactivity_expiration_enabled = false
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("netbird_peer.test", "name", "tf-acc-renamed"),
+					resource.TestCheckResourceAttr("netbird_peer.test", "ssh_enabled", "true"),
+					resource.TestCheckResourceAttr("netbird_peer.test", "inactivity_expiration_enabled", "false"),
+					testAccCheckPeerSetting(mock, peerID, "inactivity_expiration_enabled", false),
+					testAccCheckPeerSetting(mock, peerID, "ssh_enabled", true),
+				),
+			},
+			// Unset attributes keep the current settings of the peer
+			{
+				Config: providerConfig + testAccPeerResourceConfig(peerID, ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("netbird_peer.test", "name", "tf-acc-renamed"),
+					resource.TestCheckResourceAttr("netbird_peer.test", "ssh_enabled", "true"),
+					resource.TestCheckResourceAttr("netbird_peer.test", "inactivity_expiration_enabled", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccPeerResource_identity(t *testing.T) {
+	testAccMockOnly(t)
+	providerConfig, mock := testAccProviderConfig(t)
+	peerID := mock.Seed("/api/peers", map[string]any{"name": "tf-acc-peer"})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_12_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + testAccPeerResourceConfig(peerID, ""),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectIdentityValueMatchesState("netbird_peer.test", tfjsonpath.New("id")),
+				},
+			},
+			{
+				ResourceName:    "netbird_peer.test",
+				ImportState:     true,
+				ImportStateKind: resource.ImportBlockWithResourceIdentity,
+			},
+		},
+	})
+}
+
+func TestAccPeerResource_issuedBy(t *testing.T) {
+	testAccMockOnly(t)
+	providerConfig, mock := testAccProviderConfig(t)
+	peerID := mock.Seed("/api/peers", map[string]any{"name": "tf-acc-laptop", "user_id": "tf-acc-user"})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + testAccPeerResourceConfig(peerID, ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("netbird_peer.test", "issued_by", "user"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccPeerResource_unknownPeer(t *testing.T) {
+	testAccMockOnly(t)
+	providerConfig, _ := testAccProviderConfig(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      providerConfig + testAccPeerResourceConfig("missing", ""),
+				ExpectError: regexp.MustCompile(`No peer exists with ID "missing"`),
+			},
+		},
+	})
+}
+
+// testAccCheckPeerSetting checks a setting of the peer stored by the mock API.
+func testAccCheckPeerSetting(mock *testutils.MockServer, peerID, attribute string, want bool) resource.TestCheckFunc {
+	return func(*terraform.State) error {
+		peer := mock.Get("/api/peers", peerID)
+		if peer == nil {
+			return fmt.Errorf("peer %s no longer exists", peerID)
+		}
+		if got, _ := peer[attribute].(bool); got != want {
+			return fmt.Errorf("expected %s of peer %s to be %t, got %v", attribute, peerID, want, peer[attribute])
+		}
+		return nil
+	}
+}
+
+func testAccPeerResourceConfig(peerID, attributes string) string {
+	return fmt.Sprintf(`
+resource "netbird_peer" "test" {
+  id = %q
+  %s
+}
+`, peerID, attributes)
+}
@@ -2,18 +2,50 @@ package provider
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
-	"net/http"
 	"net/url"
 
+	"github.com/Masterminds/semver/v3"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"github.com/hashicorp/terraform-plugin-log/tflog"
-	netbirdApi "github.com/netbirdio/netbird/management/server/http/api"
 )
 
+// versionFilter matches versions from min, inclusive, up to max, exclusive.
+// Either bound may be nil.
+type versionFilter struct {
+	min *semver.Version
+	max *semver.Version
+}
+
+func newVersionFilter(minVersion string, maxVersion string) (versionFilter, error) {
+	var filter versionFilter
+	var err error
+	if minVersion != "" {
+		if filter.min, err = semver.NewVersion(minVersion); err != nil {
+			return filter, fmt.Errorf("invalid min_version %q: %w", minVersion, err)
+		}
+	}
+	if maxVersion != "" {
+		if filter.max, err = semver.NewVersion(maxVersion); err != nil {
+			return filter, fmt.Errorf("invalid max_version %q: %w", maxVersion, err)
+		}
+	}
+	return filter, nil
+}
+
+func (f versionFilter) matches(version string) bool {
+	if f.min == nil && f.max == nil {
+		return true
+	}
+	v, err := semver.NewVersion(version)
+	if err != nil {
+		return false
+	}
+	return (f.min == nil || !v.LessThan(f.min)) && (f.max == nil || v.LessThan(f.max))
+}
+
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ datasource.DataSource = &PeersDataSource{}
 
@@ -23,7 +55,7 @@ func NewPeersDataSource() datasource.DataSource {
 
 // PeersDataSource defines the data source implementation.
 type PeersDataSource struct {
-	client *Client
+	client ClientInterface
 }
 
 func (d *PeersDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -44,6 +76,32 @@ func (d *PeersDataSource) Schema(ctx context.Context, req datasource.SchemaReque
 				MarkdownDescription: "Filter peers by IP address",
 				Optional:            true,
 			},
+			"country_code": schema.StringAttribute{
+				MarkdownDescription: "Filter peers by the ISO 3166-1 alpha-2 country code of their location, e.g. `DE`",
+				Optional:            true,
+				Validators: []validator.String{
+					countryCodeValidator,
+				},
+			},
+			"min_version": schema.StringAttribute{
+				MarkdownDescription: "Only include peers running this NetBird version or newer, e.g. `0.40.0`. " +
+					"Peers whose version is not a semantic version, such as `development`, are excluded when filtering by version.",
+				Optional: true,
+				Validators: []validator.String{
+					versionValidator{},
+				},
+			},
+			"max_version": schema.StringAttribute{
+				MarkdownDescription: "Only include peers running a NetBird version older than this, e.g. `0.43.0` to find peers needing an upgrade.",
+				Optional:            true,
+				Validators: []validator.String{
+					versionValidator{},
+				},
+			},
+			"approval_required": schema.BoolAttribute{
+				MarkdownDescription: "Filter peers by whether they are pending approval, e.g. `true` to find the peers to approve with `netbird_peer_approval` (Cloud only)",
+				Optional:            true,
+			},
 			"peers": schema.ListNestedAttribute{
 				Computed: true,
 				NestedObject: schema.NestedAttributeObject{
@@ -70,7 +128,11 @@ func (d *PeersDataSource) Schema(ctx context.Context, req datasource.SchemaReque
 						},
 						"last_seen": schema.StringAttribute{
 							Computed:    true,
-							Description: "Timestamp of the last time the peer was seen.",
+							Description: "Timestamp of the last time the peer was seen, in RFC 3339 format.",
+						},
+						"last_seen_unix": schema.Int64Attribute{
+							Computed:    true,
+							Description: "Timestamp of the last time the peer was seen, in seconds since the Unix epoch.",
 						},
 						"os": schema.StringAttribute{
 							Computed:    true,
@@ -146,7 +208,11 @@ func (d *PeersDataSource) Schema(ctx context.Context, req datasource.SchemaReque
 						},
 						"last_login": schema.StringAttribute{
 							Computed:    true,
-							Description: "Timestamp of the last user login to the peer.",
+							Description: "Timestamp of the last user login to the peer, in RFC 3339 format. Null if no user has logged in.",
+						},
+						"last_login_unix": schema.Int64Attribute{
+							Computed:    true,
+							Description: "Timestamp of the last user login to the peer, in seconds since the Unix epoch. Null if no user has logged in.",
 						},
 						"inactivity_expiration_enabled": schema.BoolAttribute{
 							Computed:    true,
@@ -177,6 +243,14 @@ func (d *PeersDataSource) Schema(ctx context.Context, req datasource.SchemaReque
 							Computed:    true,
 							Description: "Number of peers accessible by this peer.",
 						},
+						"registered_at": schema.StringAttribute{
+							Computed:    true,
+							Description: "Timestamp of when the peer was registered. Null if the management server does not report it.",
+						},
+						"issued_by": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: peerIssuedByDescription,
+						},
 					},
 				},
 			},
@@ -190,7 +264,7 @@ func (d *PeersDataSource) Configure(ctx context.Context, req datasource.Configur
 		return
 	}
 
-	client, ok := req.ProviderData.(*Client)
+	client, ok := req.ProviderData.(ClientInterface)
 
 	if !ok {
 		resp.Diagnostics.AddError(
@@ -214,7 +288,7 @@ func (d *PeersDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 		return
 	}
 
-	endpoint := fmt.Sprintf("%s/api/peers", d.client.BaseUrl)
+	endpoint := "/api/peers"
 
 	// Initialize a query parameter map
 	queryParams := url.Values{}
@@ -234,56 +308,31 @@ func (d *PeersDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 		endpoint = fmt.Sprintf("%s?%s", endpoint, queryParams.Encode())
 	}
 
-	reqHTTP, err := http.NewRequest("GET", endpoint, nil)
-	if err != nil {
-		resp.Diagnostics.AddError("Error Creating Request", err.Error())
-		return
-	}
-
-	body, err := d.client.doRequest(reqHTTP)
+	peerList, err := getAllPages[peerWithRegistration](ctx, d.client, endpoint)
 	if err != nil {
 		resp.Diagnostics.AddError("Error Making API Request", err.Error())
 		return
 	}
 
-	tflog.Info(ctx, "Obtained peers data source response: "+string(body[:]))
-	var peerBatchList []netbirdApi.PeerBatch
-	if err := json.Unmarshal(body, &peerBatchList); err != nil {
-		resp.Diagnostics.AddError("Error Parsing API Response", err.Error())
+	versionFilter, err := newVersionFilter(data.MinVersion.ValueString(), data.MaxVersion.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid version filter", err.Error())
 		return
 	}
 
 	var peers []PeerDataSourceModel
-	for _, peerBatch := range peerBatchList {
-		peer := PeerDataSourceModel{
-			ID:                          types.StringValue(peerBatch.Id),
-			Name:                        types.StringValue(peerBatch.Name),
-			IP:                          types.StringValue(peerBatch.Ip),
-			ConnectionIP:                types.StringValue(peerBatch.ConnectionIp),
-			Connected:                   types.BoolValue(peerBatch.Connected),
-			LastSeen:                    types.StringValue(peerBatch.LastSeen.String()),
-			OS:                          types.StringValue(peerBatch.Os),
-			KernelVersion:               types.StringValue(peerBatch.KernelVersion),
-			GeonameID:                   types.Int64Value(int64(peerBatch.GeonameId)),
-			Version:                     types.StringValue(peerBatch.Version),
-			Groups:                      convertPeerGroups(peerBatch.Groups), // Helper function to convert groups
-			SSHEnabled:                  types.BoolValue(peerBatch.SshEnabled),
-			UserID:                      types.StringValue(peerBatch.UserId),
-			Hostname:                    types.StringValue(peerBatch.Hostname),
-			UIVersion:                   types.StringValue(peerBatch.UiVersion),
-			DNSLabel:                    types.StringValue(peerBatch.DnsLabel),
-			LoginExpirationEnabled:      types.BoolValue(peerBatch.LoginExpirationEnabled),
-			LoginExpired:                types.BoolValue(peerBatch.LoginExpired),
-			LastLogin:                   types.StringValue(peerBatch.LastLogin.String()),
-			InactivityExpirationEnabled: types.BoolValue(peerBatch.InactivityExpirationEnabled),
-			ApprovalRequired:            types.BoolValue(peerBatch.ApprovalRequired),
-			CountryCode:                 types.StringValue(peerBatch.CountryCode),
-			CityName:                    types.StringValue(peerBatch.CityName),
-			SerialNumber:                types.StringValue(peerBatch.SerialNumber),
-			ExtraDNSLabels:              convertStrings(peerBatch.ExtraDnsLabels), // Convert list of strings
-			AccessiblePeersCount:        types.Int64Value(int64(peerBatch.AccessiblePeersCount)),
+	for _, peerBatch := range peerList {
+		// The API can't filter by location, version or approval, so filter client-side
+		if !data.CountryCode.IsNull() && peerBatch.CountryCode != data.CountryCode.ValueString() {
+			continue
+		}
+		if !data.ApprovalRequired.IsNull() && peerBatch.ApprovalRequired != data.ApprovalRequired.ValueBool() {
+			continue
+		}
+		if !versionFilter.matches(peerBatch.Version) {
+			continue
 		}
-		peers = append(peers, peer)
+		peers = append(peers, convertPeerToDataSourceModel(peerBatch))
 	}
 	data.Peers = peers
 
@@ -0,0 +1,177 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	netbirdApi "github.com/netbirdio/netbird/management/server/http/api"
+)
+
+func TestAccPeersDataSource(t *testing.T) {
+	testAccMockOnly(t)
+	providerConfig, mock := testAccProviderConfig(t)
+	mock.Seed("/api/peers", netbirdApi.PeerBatch{Name: "tf-acc-peer-a", Ip: "100.64.0.1", CountryCode: "DE"})
+	mock.Seed("/api/peers", netbirdApi.PeerBatch{Name: "tf-acc-peer-b", Ip: "100.64.0.2", CountryCode: "GB", LastSeen: time.Date(2025, 3, 2, 7, 30, 0, 0, time.UTC)})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+data "netbird_peers" "all" {}
+
+data "netbird_peers" "by_name" {
+  name = "tf-acc-peer-b"
+}
+
+data "netbird_peers" "by_country" {
+  country_code = "DE"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.netbird_peers.all", "peers.#", "2"),
+					resource.TestCheckResourceAttr("data.netbird_peers.by_name", "peers.#", "1"),
+					resource.TestCheckResourceAttr("data.netbird_peers.by_name", "peers.0.ip", "100.64.0.2"),
+					resource.TestCheckResourceAttr("data.netbird_peers.by_name", "peers.0.last_seen", "2025-03-02T07:30:00Z"),
+					resource.TestCheckResourceAttr("data.netbird_peers.by_name", "peers.0.last_seen_unix", "1740900600"),
+					resource.TestCheckResourceAttr("data.netbird_peers.by_country", "peers.#", "1"),
+					resource.TestCheckResourceAttr("data.netbird_peers.by_country", "peers.0.name", "tf-acc-peer-a"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccPeersDataSource_version(t *testing.T) {
+	testAccMockOnly(t)
+	providerConfig, mock := testAccProviderConfig(t)
+	mock.Seed("/api/peers", netbirdApi.PeerBatch{Name: "tf-acc-peer-old", Version: "0.38.2"})
+	mock.Seed("/api/peers", netbirdApi.PeerBatch{Name: "tf-acc-peer-current", Version: "0.43.0"})
+	mock.Seed("/api/peers", netbirdApi.PeerBatch{Name: "tf-acc-peer-dev", Version: "development"})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+data "netbird_peers" "outdated" {
+  max_version = "0.43.0"
+}
+
+data "netbird_peers" "current" {
+  min_version = "0.43.0"
+}
+
+data "netbird_peers" "range" {
+  min_version = "0.30"
+  max_version = "0.50"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.netbird_peers.outdated", "peers.#", "1"),
+					resource.TestCheckResourceAttr("data.netbird_peers.outdated", "peers.0.name", "tf-acc-peer-old"),
+					resource.TestCheckResourceAttr("data.netbird_peers.current", "peers.#", "1"),
+					resource.TestCheckResourceAttr("data.netbird_peers.current", "peers.0.name", "tf-acc-peer-current"),
+					resource.TestCheckResourceAttr("data.netbird_peers.range", "peers.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccPeersDataSource_approvalRequired(t *testing.T) {
+	testAccMockOnly(t)
+	providerConfig, mock := testAccProviderConfig(t)
+	mock.Seed("/api/peers", netbirdApi.PeerBatch{Name: "tf-acc-peer-approved"})
+	pendingPeerID := mock.Seed("/api/peers", netbirdApi.PeerBatch{Name: "tf-acc-peer-pending", ApprovalRequired: true})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+data "netbird_peers" "pending" {
+  approval_required = true
+}
+
+data "netbird_peers" "approved" {
+  approval_required = false
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.netbird_peers.pending", "peers.#", "1"),
+					resource.TestCheckResourceAttr("data.netbird_peers.pending", "peers.0.id", pendingPeerID),
+					resource.TestCheckResourceAttr("data.netbird_peers.pending", "peers.0.approval_required", "true"),
+					resource.TestCheckResourceAttr("data.netbird_peers.approved", "peers.#", "1"),
+					resource.TestCheckResourceAttr("data.netbird_peers.approved", "peers.0.name", "tf-acc-peer-approved"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccPeersDataSource_invalidVersion(t *testing.T) {
+	providerConfig, _ := testAccProviderConfig(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+data "netbird_peers" "invalid" {
+  min_version = "latest"
+}
+`,
+				ExpectError: regexp.MustCompile("semantic version"),
+			},
+		},
+	})
+}
+
+func TestVersionFilter(t *testing.T) {
+	filter, err := newVersionFilter("0.40.0", "0.43.0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for version, want := range map[string]bool{
+		"0.39.9":      false,
+		"0.40.0":      true,
+		"v0.42.1":     true,
+		"0.43.0":      false,
+		"development": false,
+		"":            false,
+	} {
+		if got := filter.matches(version); got != want {
+			t.Errorf("%q: expected %t, got %t", version, want, got)
+		}
+	}
+
+	if !(versionFilter{}).matches("development") {
+		t.Error("expected every version to match without bounds")
+	}
+}
+
+func TestAccPeersDataSource_invalidCountryCode(t *testing.T) {
+	providerConfig, _ := testAccProviderConfig(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+data "netbird_peers" "invalid" {
+  country_code = "de"
+}
+`,
+				ExpectError: regexp.MustCompile("ISO 3166-1 alpha-2"),
+			},
+		},
+	})
+}
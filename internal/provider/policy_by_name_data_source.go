@@ -0,0 +1,213 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	netbirdApi "github.com/netbirdio/netbird/management/server/http/api"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &PolicyByNameDataSource{}
+
+func NewPolicyByNameDataSource() datasource.DataSource {
+	return &PolicyByNameDataSource{}
+}
+
+// PolicyByNameDataSource defines the data source implementation.
+type PolicyByNameDataSource struct {
+	client ClientInterface
+}
+
+func (d *PolicyByNameDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_policy_by_name"
+}
+
+func (d *PolicyByNameDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resourceAttributes := map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed:    true,
+			Description: "ID of the resource.",
+		},
+		"type": schema.StringAttribute{
+			Computed:    true,
+			Description: "Network resource type based of the address.",
+		},
+	}
+
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Retrieve a policy, including its rules, by name. Fails if no policy or more than one policy has the name.",
+
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "Name of the policy.",
+			},
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Unique identifier of the policy.",
+			},
+			"description": schema.StringAttribute{
+				Computed:    true,
+				Description: "Description of the policy.",
+			},
+			"enabled": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Indicates whether the policy is enabled.",
+			},
+			"source_posture_checks": schema.SetAttribute{
+				Computed:    true,
+				Description: "Source posture check IDs of the policy.",
+				ElementType: types.StringType,
+			},
+			"rules": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Rules of the policy.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "Unique identifier of the rule.",
+						},
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "Name of the rule.",
+						},
+						"description": schema.StringAttribute{
+							Computed:    true,
+							Description: "Description of the rule.",
+						},
+						"enabled": schema.BoolAttribute{
+							Computed:    true,
+							Description: "Indicates whether the rule is enabled.",
+						},
+						"action": schema.StringAttribute{
+							Computed:    true,
+							Description: "Whether the rule accepts or drops packets.",
+						},
+						"bidirectional": schema.BoolAttribute{
+							Computed:    true,
+							Description: "Indicates whether the rule applies in both directions.",
+						},
+						"protocol": schema.StringAttribute{
+							Computed:    true,
+							Description: "Traffic protocol of the rule.",
+						},
+						"ports": schema.ListAttribute{
+							Computed:    true,
+							Description: "Ports affected by the rule.",
+							ElementType: types.StringType,
+						},
+						"port_ranges": schema.ListNestedAttribute{
+							Computed:    true,
+							Description: "Port ranges affected by the rule.",
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"start": schema.Int32Attribute{
+										Computed:    true,
+										Description: "Start port.",
+									},
+									"end": schema.Int32Attribute{
+										Computed:    true,
+										Description: "End port.",
+									},
+								},
+							},
+						},
+						"sources": schema.ListAttribute{
+							Computed:    true,
+							Description: "Source group IDs of the rule.",
+							ElementType: types.StringType,
+						},
+						"destinations": schema.ListAttribute{
+							Computed:    true,
+							Description: "Destination group IDs of the rule.",
+							ElementType: types.StringType,
+						},
+						"source_resource": schema.SingleNestedAttribute{
+							Computed:    true,
+							Description: "Source resource of the rule.",
+							Attributes:  resourceAttributes,
+						},
+						"destination_resource": schema.SingleNestedAttribute{
+							Computed:    true,
+							Description: "Destination resource of the rule.",
+							Attributes:  resourceAttributes,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *PolicyByNameDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(ClientInterface)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *PolicyByNameDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data PolicyModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	policies, err := getJSON[[]netbirdApi.Policy](ctx, d.client, "/api/policies")
+	if err != nil {
+		resp.Diagnostics.AddError("Error Making API Request", err.Error())
+		return
+	}
+	if policies == nil {
+		policies = &[]netbirdApi.Policy{}
+	}
+
+	var matches []netbirdApi.Policy
+	for _, policy := range *policies {
+		if policy.Name == data.Name.ValueString() {
+			matches = append(matches, policy)
+		}
+	}
+
+	if len(matches) == 0 {
+		resp.Diagnostics.AddError("Policy Not Found", fmt.Sprintf("No policy named %q was found.", data.Name.ValueString()))
+		return
+	}
+	if len(matches) > 1 {
+		resp.Diagnostics.AddError(
+			"Multiple Policies Found",
+			fmt.Sprintf("%d policies are named %q. Rename the policies or reference one by ID.", len(matches), data.Name.ValueString()),
+		)
+		return
+	}
+
+	data, diags := convertPolicyFromApiModel(matches[0])
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,68 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	netbirdApi "github.com/netbirdio/netbird/management/server/http/api"
+)
+
+func TestAccPolicyByNameDataSource(t *testing.T) {
+	providerConfig, _ := testAccProviderConfig(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + testAccPolicyResourceConfig(true, "80") + `
+data "netbird_policy_by_name" "test" {
+  name = netbird_policy.test.name
+
+  depends_on = [netbird_policy.test]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.netbird_policy_by_name.test", "id", "netbird_policy.test", "id"),
+					resource.TestCheckResourceAttr("data.netbird_policy_by_name.test", "enabled", "true"),
+					resource.TestCheckResourceAttr("data.netbird_policy_by_name.test", "rules.#", "1"),
+					resource.TestCheckResourceAttr("data.netbird_policy_by_name.test", "rules.0.name", "tf-acc-rule"),
+					resource.TestCheckResourceAttr("data.netbird_policy_by_name.test", "rules.0.ports.0", "80"),
+					resource.TestCheckResourceAttrPair("data.netbird_policy_by_name.test", "rules.0.sources.0", "netbird_group.source", "id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccPolicyByNameDataSource_duplicateName(t *testing.T) {
+	testAccMockOnly(t)
+	providerConfig, mock := testAccProviderConfig(t)
+	for range 2 {
+		mock.Seed("/api/policies", netbirdApi.Policy{Name: "tf-acc-duplicate", Rules: []netbirdApi.PolicyRule{}})
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+data "netbird_policy_by_name" "test" {
+  name = "tf-acc-duplicate"
+}
+`,
+				ExpectError: regexp.MustCompile("Multiple Policies Found"),
+			},
+			{
+				Config: providerConfig + `
+data "netbird_policy_by_name" "test" {
+  name = "tf-acc-missing"
+}
+`,
+				ExpectError: regexp.MustCompile("Policy Not Found"),
+			},
+		},
+	})
+}
@@ -0,0 +1,245 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	netbirdApi "github.com/netbirdio/netbird/management/server/http/api"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &PolicyEnabledResource{}
+var _ resource.ResourceWithImportState = &PolicyEnabledResource{}
+
+func NewPolicyEnabledResource() resource.Resource {
+	return &PolicyEnabledResource{}
+}
+
+// PolicyEnabledResource manages only the enabled flag of a policy.
+type PolicyEnabledResource struct {
+	client ClientInterface
+}
+
+type PolicyEnabledResourceModel struct {
+	ID       types.String `tfsdk:"id"`
+	PolicyID types.String `tfsdk:"policy_id"`
+	Enabled  types.Bool   `tfsdk:"enabled"`
+}
+
+func (r *PolicyEnabledResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_policy_enabled"
+}
+
+func (r *PolicyEnabledResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Enables or disables an existing policy, e.g. during maintenance, leaving its rules untouched. " +
+			"The API has no partial updates, so the current policy is read and written back with only `enabled` changed. " +
+			"When the policy is also managed by a `netbird_policy` resource, add `enabled` to its `ignore_changes`. " +
+			"Destroying the resource only removes it from the Terraform state.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Policy ID",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"policy_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the policy",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether the policy is enabled",
+				Required:            true,
+			},
+		},
+	}
+}
+
+func (r *PolicyEnabledResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(ClientInterface)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// getPolicy returns the policy, or nil if it does not exist.
+func (r *PolicyEnabledResource) getPolicy(ctx context.Context, id string) (*netbirdApi.Policy, diag.Diagnostics) {
+	diags := diag.Diagnostics{}
+	responseData, err := getJSON[netbirdApi.Policy](ctx, r.client, fmt.Sprintf("/api/policies/%s", id))
+	if err != nil {
+		diags.AddError("Error fetching policy", err.Error())
+	}
+	return responseData, diags
+}
+
+// setEnabled writes the policy back with only the enabled flag changed.
+func (r *PolicyEnabledResource) setEnabled(ctx context.Context, data *PolicyEnabledResourceModel) diag.Diagnostics {
+	policy, diags := r.getPolicy(ctx, data.PolicyID.ValueString())
+	if diags.HasError() {
+		return diags
+	}
+	if policy == nil || policy.Id == nil {
+		diags.AddAttributeError(
+			path.Root("policy_id"),
+			"Policy not found",
+			fmt.Sprintf("No policy exists with ID %q.", data.PolicyID.ValueString()),
+		)
+		return diags
+	}
+
+	// Skip the update when the policy already has the requested state
+	if policy.Enabled != data.Enabled.ValueBool() {
+		_, err := putJSON[netbirdApi.Policy](ctx, r.client, fmt.Sprintf("/api/policies/%s", *policy.Id), netbirdApi.PolicyUpdate{
+			Name:                policy.Name,
+			Description:         policy.Description,
+			Enabled:             data.Enabled.ValueBool(),
+			Rules:               policyRuleUpdates(policy.Rules),
+			SourcePostureChecks: &policy.SourcePostureChecks,
+		})
+		if err != nil {
+			diags.AddError("Error updating policy", err.Error())
+			return diags
+		}
+	}
+
+	data.ID = types.StringValue(*policy.Id)
+	return diags
+}
+
+// policyRuleUpdates converts rules read from the API into the form they are
+// written in, keeping rule IDs so the rules are updated rather than replaced.
+func policyRuleUpdates(rules []netbirdApi.PolicyRule) []netbirdApi.PolicyRuleUpdate {
+	updates := make([]netbirdApi.PolicyRuleUpdate, 0, len(rules))
+	for _, rule := range rules {
+		updates = append(updates, netbirdApi.PolicyRuleUpdate{
+			Id:                  rule.Id,
+			Name:                rule.Name,
+			Description:         rule.Description,
+			Enabled:             rule.Enabled,
+			Action:              netbirdApi.PolicyRuleUpdateAction(rule.Action),
+			Bidirectional:       rule.Bidirectional,
+			Protocol:            netbirdApi.PolicyRuleUpdateProtocol(rule.Protocol),
+			Ports:               rule.Ports,
+			PortRanges:          rule.PortRanges,
+			Sources:             groupMinimumIDs(rule.Sources),
+			SourceResource:      rule.SourceResource,
+			Destinations:        groupMinimumIDs(rule.Destinations),
+			DestinationResource: rule.DestinationResource,
+		})
+	}
+	return updates
+}
+
+// groupMinimumIDs returns the IDs of groups, or nil if groups is nil.
+func groupMinimumIDs(groups *[]netbirdApi.GroupMinimum) *[]string {
+	if groups == nil {
+		return nil
+	}
+	ids := make([]string, 0, len(*groups))
+	for _, group := range *groups {
+		ids = append(ids, group.Id)
+	}
+	return &ids
+}
+
+func (r *PolicyEnabledResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data PolicyEnabledResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.setEnabled(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PolicyEnabledResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data PolicyEnabledResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	policy, diags := r.getPolicy(ctx, data.ID.ValueString())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The policy has been deleted
+	if policy == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.PolicyID = data.ID
+	data.Enabled = types.BoolValue(policy.Enabled)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PolicyEnabledResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data PolicyEnabledResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.setEnabled(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PolicyEnabledResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// The policy is left in its current state.
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *PolicyEnabledResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
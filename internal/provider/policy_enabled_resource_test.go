@@ -0,0 +1,89 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccPolicyEnabledResource(t *testing.T) {
+	providerConfig, mock := testAccProviderConfig(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckDestroy(mock, "netbird_policy", staticPath("/api/policies")),
+		Steps: []resource.TestStep{
+			// Create and Read testing, the policy is read back to verify only enabled changed
+			{
+				Config: providerConfig + testAccPolicyEnabledResourceConfig(false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("netbird_policy_enabled.test", "id", "netbird_policy.test", "id"),
+					resource.TestCheckResourceAttr("netbird_policy_enabled.test", "enabled", "false"),
+					resource.TestCheckResourceAttr("data.netbird_policy_by_name.test", "enabled", "false"),
+					resource.TestCheckResourceAttr("data.netbird_policy_by_name.test", "rules.#", "1"),
+					resource.TestCheckResourceAttrPair("data.netbird_policy_by_name.test", "rules.0.id", "netbird_policy.test", "rules.0.id"),
+					resource.TestCheckResourceAttr("data.netbird_policy_by_name.test", "rules.0.ports.0", "443"),
+					resource.TestCheckResourceAttrPair("data.netbird_policy_by_name.test", "rules.0.sources.0", "netbird_group.test", "id"),
+					resource.TestCheckResourceAttrPair("data.netbird_policy_by_name.test", "rules.0.destinations.0", "netbird_group.test", "id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "netbird_policy_enabled.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + testAccPolicyEnabledResourceConfig(true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("netbird_policy_enabled.test", "enabled", "true"),
+					resource.TestCheckResourceAttr("data.netbird_policy_by_name.test", "enabled", "true"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccPolicyEnabledResourceConfig(enabled bool) string {
+	return fmt.Sprintf(`
+resource "netbird_group" "test" {
+  name = "tf-acc-policy-enabled"
+}
+
+resource "netbird_policy" "test" {
+  name    = "tf-acc-policy-enabled"
+  enabled = true
+  rules = [
+    {
+      name          = "tf-acc-rule"
+      enabled       = true
+      action        = "accept"
+      bidirectional = true
+      protocol      = "tcp"
+      ports         = ["443"]
+      sources       = [netbird_group.test.id]
+      destinations  = [netbird_group.test.id]
+    }
+  ]
+
+  lifecycle {
+    ignore_changes = [enabled]
+  }
+}
+
+resource "netbird_policy_enabled" "test" {
+  policy_id = netbird_policy.test.id
+  enabled   = %t
+}
+
+data "netbird_policy_by_name" "test" {
+  name = netbird_policy.test.name
+
+  depends_on = [netbird_policy_enabled.test]
+}
+`, enabled)
+}
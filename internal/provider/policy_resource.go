@@ -1,29 +1,34 @@
 package provider
 
 import (
-	"bytes"
 	"context"
-	"encoding/json"
 	"fmt"
-	"net/http"
+	"slices"
+	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
-	"github.com/hashicorp/terraform-plugin-log/tflog"
 	netbirdApi "github.com/netbirdio/netbird/management/server/http/api"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &PolicyResource{}
 var _ resource.ResourceWithImportState = &PolicyResource{}
+var _ resource.ResourceWithIdentity = &PolicyResource{}
+var _ resource.ResourceWithUpgradeState = &PolicyResource{}
+var _ resource.ResourceWithModifyPlan = &PolicyResource{}
+var _ resource.ResourceWithConfigValidators = &PolicyResource{}
 
 func NewPolicyResource() resource.Resource {
 	return &PolicyResource{}
@@ -31,7 +36,7 @@ func NewPolicyResource() resource.Resource {
 
 // PolicyResource defines the resource implementation.
 type PolicyResource struct {
-	client *Client
+	client ClientInterface
 }
 
 type PolicyModel struct {
@@ -39,10 +44,49 @@ type PolicyModel struct {
 	Name                types.String      `tfsdk:"name"`
 	Description         types.String      `tfsdk:"description"`
 	Enabled             types.Bool        `tfsdk:"enabled"`
-	SourcePostureChecks types.List        `tfsdk:"source_posture_checks"`
+	SourcePostureChecks types.Set         `tfsdk:"source_posture_checks"`
 	Rules               []PolicyRuleModel `tfsdk:"rules"`
 }
 
+// PolicyResourceModel is the PolicyModel shared with the policy data source,
+// plus the arguments only the resource has.
+type PolicyResourceModel struct {
+	PolicyModel
+	SourcePostureCheckNames types.Set      `tfsdk:"source_posture_check_names"`
+	ValidateReferences      types.Bool     `tfsdk:"validate_references"`
+	Timeouts                timeouts.Value `tfsdk:"timeouts"`
+}
+
+// policyResourceModelV0 is the state of schema version 0, storing
+// source_posture_checks as a list.
+type policyResourceModelV0 struct {
+	ID                  types.String        `tfsdk:"id"`
+	Name                types.String        `tfsdk:"name"`
+	Description         types.String        `tfsdk:"description"`
+	Enabled             types.Bool          `tfsdk:"enabled"`
+	SourcePostureChecks types.List          `tfsdk:"source_posture_checks"`
+	Rules               []policyRuleModelV0 `tfsdk:"rules"`
+	Timeouts            timeouts.Value      `tfsdk:"timeouts"`
+}
+
+// policyRuleModelV0 is a rule in schema version 0, storing
+// destination_resource as a list.
+type policyRuleModelV0 struct {
+	ID                  types.String     `tfsdk:"id"`
+	Name                types.String     `tfsdk:"name"`
+	Description         types.String     `tfsdk:"description"`
+	Enabled             types.Bool       `tfsdk:"enabled"`
+	Action              types.String     `tfsdk:"action"`
+	Bidirectional       types.Bool       `tfsdk:"bidirectional"`
+	Protocol            types.String     `tfsdk:"protocol"`
+	Ports               types.List       `tfsdk:"ports"`
+	PortRanges          []PortRangeModel `tfsdk:"port_ranges"`
+	Sources             types.List       `tfsdk:"sources"`
+	Destinations        types.List       `tfsdk:"destinations"`
+	SourceResource      *ResourceModel   `tfsdk:"source_resource"`
+	DestinationResource []ResourceModel  `tfsdk:"destination_resource"`
+}
+
 // ResourceModel represents a source or destination resource in a policy.
 type ResourceModel struct {
 	ID   types.String `tfsdk:"id"`
@@ -80,6 +124,7 @@ func (r *PolicyResource) Schema(ctx context.Context, req resource.SchemaRequest,
 	resp.Schema = schema.Schema{
 		// This description is used by the documentation generator and the language server.
 		MarkdownDescription: "Policy resource",
+		Version:             1,
 
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
@@ -103,12 +148,22 @@ func (r *PolicyResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				Required:            true,
 				MarkdownDescription: "Policy status",
 			},
-			"source_posture_checks": schema.ListAttribute{
+			"source_posture_checks": schema.SetAttribute{
 				ElementType:         types.StringType,
-				MarkdownDescription: "List of source posture check IDs",
+				MarkdownDescription: "Set of source posture check IDs. Conflicts with `source_posture_check_names`",
 				Optional:            true,
 				Computed:            true,
 			},
+			"source_posture_check_names": schema.SetAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "Set of source posture check names, resolved to IDs in `source_posture_checks` at apply time. Conflicts with `source_posture_checks`",
+				Optional:            true,
+			},
+			"validate_references": schema.BoolAttribute{
+				Optional: true,
+				MarkdownDescription: "Check during plan that the groups in `sources` and `destinations` and the `source_posture_checks` exist. " +
+					"Costs one request listing all groups, and one listing all posture checks when any are set.",
+			},
 			"rules": schema.ListNestedAttribute{
 				Required:            true,
 				MarkdownDescription: "List of policy rules",
@@ -137,8 +192,10 @@ func (r *PolicyResource) Schema(ctx context.Context, req resource.SchemaRequest,
 							MarkdownDescription: "Policy rule `accept` or `drop` packets",
 						},
 						"bidirectional": schema.BoolAttribute{
-							Required:            true,
-							MarkdownDescription: "Define if the rule is applicable in both directions, sources, and destinations",
+							Optional:            true,
+							Computed:            true,
+							MarkdownDescription: "Define if the rule is applicable in both directions, sources, and destinations. Defaults to `true`",
+							Default:             booldefault.StaticBool(true),
 						},
 						"protocol": schema.StringAttribute{
 							Required:            true,
@@ -148,7 +205,10 @@ func (r *PolicyResource) Schema(ctx context.Context, req resource.SchemaRequest,
 							ElementType:         types.StringType,
 							Optional:            true,
 							Computed:            true,
-							MarkdownDescription: "List of affected ports",
+							MarkdownDescription: "List of affected ports, see the `ports` provider function for expanding ranges",
+							Validators: []validator.List{
+								portListValidator{},
+							},
 						},
 						"port_ranges": schema.ListNestedAttribute{
 							Optional:            true,
@@ -190,19 +250,17 @@ func (r *PolicyResource) Schema(ctx context.Context, req resource.SchemaRequest,
 								},
 							},
 						},
-						"destination_resource": schema.ListNestedAttribute{
+						"destination_resource": schema.SingleNestedAttribute{
 							Optional:            true,
-							MarkdownDescription: "Source resources",
-							NestedObject: schema.NestedAttributeObject{
-								Attributes: map[string]schema.Attribute{
-									"id": schema.StringAttribute{
-										Required:            true,
-										MarkdownDescription: "ID of the resource",
-									},
-									"type": schema.StringAttribute{
-										Required:            true,
-										MarkdownDescription: "Network resource type based of the address",
-									},
+							MarkdownDescription: "Destination resources",
+							Attributes: map[string]schema.Attribute{
+								"id": schema.StringAttribute{
+									Required:            true,
+									MarkdownDescription: "ID of the resource",
+								},
+								"type": schema.StringAttribute{
+									Required:            true,
+									MarkdownDescription: "Network resource type based of the address",
 								},
 							},
 						},
@@ -210,16 +268,28 @@ func (r *PolicyResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
+func (r *PolicyResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentitySchema("policy")
+}
+
 func (r *PolicyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	// Prevent panic if the provider has not been configured.
 	if req.ProviderData == nil {
 		return
 	}
 
-	client, ok := req.ProviderData.(*Client)
+	client, ok := req.ProviderData.(ClientInterface)
 
 	if !ok {
 		resp.Diagnostics.AddError(
@@ -233,6 +303,126 @@ func (r *PolicyResource) Configure(ctx context.Context, req resource.ConfigureRe
 	r.client = client
 }
 
+func (r *PolicyResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		policySourcePostureChecksValidator{},
+	}
+}
+
+// policySourcePostureChecksValidator checks that source posture checks are
+// given either by ID or by name.
+type policySourcePostureChecksValidator struct{}
+
+func (v policySourcePostureChecksValidator) Description(ctx context.Context) string {
+	return "source_posture_checks and source_posture_check_names cannot both be set"
+}
+
+func (v policySourcePostureChecksValidator) MarkdownDescription(ctx context.Context) string {
+	return "`source_posture_checks` and `source_posture_check_names` cannot both be set"
+}
+
+func (v policySourcePostureChecksValidator) ValidateResource(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var sourcePostureChecks, sourcePostureCheckNames types.Set
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("source_posture_checks"), &sourcePostureChecks)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("source_posture_check_names"), &sourcePostureCheckNames)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !sourcePostureChecks.IsNull() && !sourcePostureCheckNames.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("source_posture_check_names"),
+			"Conflicting source posture checks",
+			"Source posture checks can be given either by ID with source_posture_checks or by name with source_posture_check_names, but not both.",
+		)
+	}
+}
+
+func (r *PolicyResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check when destroying or before the provider is configured
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var validateReferences types.Bool
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("validate_references"), &validateReferences)...)
+	if resp.Diagnostics.HasError() || !validateReferences.ValueBool() {
+		return
+	}
+
+	var data PolicyResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Keep the attribute of each reference, so errors point at the rule
+	var groupReferences []policyReferences
+	for i, rule := range data.Rules {
+		rulePath := path.Root("rules").AtListIndex(i)
+		groupReferences = append(groupReferences,
+			policyReferences{rulePath.AtName("sources"), knownStrings(rule.Sources.Elements())},
+			policyReferences{rulePath.AtName("destinations"), knownStrings(rule.Destinations.Elements())},
+		)
+	}
+	postureCheckReferences := policyReferences{path.Root("source_posture_checks"), knownStrings(data.SourcePostureChecks.Elements())}
+
+	if slices.ContainsFunc(groupReferences, func(references policyReferences) bool { return len(references.ids) > 0 }) {
+		groups, err := listGroups(ctx, r.client)
+		if err != nil {
+			resp.Diagnostics.AddError("Error fetching groups", err.Error())
+			return
+		}
+		existing := map[string]bool{}
+		for _, group := range groups {
+			existing[group.Id] = true
+		}
+		for _, references := range groupReferences {
+			references.check(&resp.Diagnostics, "group", existing)
+		}
+	}
+
+	if len(postureCheckReferences.ids) > 0 {
+		postureChecks, err := getJSON[[]netbirdApi.PostureCheck](ctx, r.client, "/api/posture-checks")
+		if err != nil {
+			resp.Diagnostics.AddError("Error fetching posture checks", err.Error())
+			return
+		}
+		existing := map[string]bool{}
+		if postureChecks != nil {
+			for _, postureCheck := range *postureChecks {
+				existing[postureCheck.Id] = true
+			}
+		}
+		postureCheckReferences.check(&resp.Diagnostics, "posture check", existing)
+	}
+}
+
+// policyReferences are the IDs configured in one policy attribute. IDs which
+// are not known until apply, such as those of new groups, are left out.
+type policyReferences struct {
+	path path.Path
+	ids  []string
+}
+
+// check adds an error listing the IDs which are not in existing.
+func (p policyReferences) check(diags *diag.Diagnostics, object string, existing map[string]bool) {
+	var missing []string
+	for _, id := range p.ids {
+		if !existing[id] {
+			missing = append(missing, id)
+		}
+	}
+	if len(missing) == 0 {
+		return
+	}
+	diags.AddAttributeError(
+		p.path,
+		fmt.Sprintf("Unknown %s IDs", object),
+		fmt.Sprintf("The following %s IDs do not exist: %s.", object, strings.Join(missing, ", ")),
+	)
+}
+
 func convertToRulesResourcesApiModel(modelResource *ResourceModel) (*netbirdApi.Resource, diag.Diagnostics) {
 	var diags diag.Diagnostics
 	if modelResource == nil {
@@ -298,7 +488,7 @@ func convertToRulesUpdateApiModel(modelRules *[]PolicyRuleModel) ([]netbirdApi.P
 			return apiRules, diags
 		}
 
-		destinationResource, diags := convertToRulesResourcesApiModel(modelRule.SourceResource)
+		destinationResource, diags := convertToRulesResourcesApiModel(modelRule.DestinationResource)
 		if diags.HasError() {
 			return apiRules, diags
 		}
@@ -405,11 +595,11 @@ func convertPolicyFromApiModel(data netbirdApi.Policy) (PolicyModel, diag.Diagno
 	for _, val := range data.SourcePostureChecks {
 		sourcePostureChecks = append(sourcePostureChecks, types.StringValue(val))
 	}
-	sourcePostureChecksListValue, diags := types.ListValue(types.StringType, sourcePostureChecks)
+	sourcePostureChecksSetValue, diags := types.SetValue(types.StringType, sourcePostureChecks)
 	if diags.HasError() {
 		return policyModel, diags
 	}
-	policyModel.SourcePostureChecks = sourcePostureChecksListValue
+	policyModel.SourcePostureChecks = sourcePostureChecksSetValue
 
 	rules, diags := convertRulesFromAPI(&data.Rules)
 	if diags.HasError() {
@@ -420,6 +610,74 @@ func convertPolicyFromApiModel(data netbirdApi.Policy) (PolicyModel, diag.Diagno
 	return policyModel, diags
 }
 
+// convertPolicyFromApiModelLike converts data like convertPolicyFromApiModel,
+// keeping the group lists of rules null where they are null in prior, see
+// preserveNullList. Rules without a prior rule, e.g. when importing, keep empty
+// lists null.
+func convertPolicyFromApiModelLike(data netbirdApi.Policy, prior PolicyModel) (PolicyModel, diag.Diagnostics) {
+	policyModel, diags := convertPolicyFromApiModel(data)
+	for i := range policyModel.Rules {
+		priorSources, priorDestinations := types.ListNull(types.StringType), types.ListNull(types.StringType)
+		if i < len(prior.Rules) {
+			priorSources, priorDestinations = prior.Rules[i].Sources, prior.Rules[i].Destinations
+		}
+		policyModel.Rules[i].Sources = preserveNullList(policyModel.Rules[i].Sources, priorSources)
+		policyModel.Rules[i].Destinations = preserveNullList(policyModel.Rules[i].Destinations, priorDestinations)
+	}
+	return policyModel, diags
+}
+
+// resolveSourcePostureChecks returns the IDs of the source posture checks,
+// looking up posture checks configured by name.
+func (r *PolicyResource) resolveSourcePostureChecks(ctx context.Context, data *PolicyResourceModel) ([]string, diag.Diagnostics) {
+	if data.SourcePostureCheckNames.IsNull() || data.SourcePostureCheckNames.IsUnknown() {
+		return convertSetToStringSlice(data.SourcePostureChecks)
+	}
+
+	names, diags := convertSetToStringSlice(data.SourcePostureCheckNames)
+	if diags.HasError() {
+		return nil, diags
+	}
+	ids, err := resolvePostureCheckNames(ctx, r.client, names)
+	if err != nil {
+		diags.AddAttributeError(path.Root("source_posture_check_names"), "Error resolving posture check names", err.Error())
+		return nil, diags
+	}
+	return ids, diags
+}
+
+// resolvePostureCheckNames looks up the IDs of the named posture checks,
+// failing if a name matches no posture check or more than one.
+func resolvePostureCheckNames(ctx context.Context, client ClientInterface, names []string) ([]string, error) {
+	postureChecks, err := getJSON[[]netbirdApi.PostureCheck](ctx, client, "/api/posture-checks")
+	if err != nil {
+		return nil, err
+	}
+
+	idsByName := map[string][]string{}
+	if postureChecks != nil {
+		for _, postureCheck := range *postureChecks {
+			idsByName[postureCheck.Name] = append(idsByName[postureCheck.Name], postureCheck.Id)
+		}
+	}
+
+	ids, missing := []string{}, []string{}
+	for _, name := range names {
+		switch matches := idsByName[name]; len(matches) {
+		case 0:
+			missing = append(missing, name)
+		case 1:
+			ids = append(ids, matches[0])
+		default:
+			return nil, fmt.Errorf("posture check name %q is ambiguous, it matches posture checks %s", name, strings.Join(matches, ", "))
+		}
+	}
+	if len(missing) > 0 {
+		return nil, fmt.Errorf("no posture check found with name: %s", strings.Join(missing, ", "))
+	}
+	return ids, nil
+}
+
 func convertListToStringSlice(list basetypes.ListValue) ([]string, diag.Diagnostics) {
 	result := []string{}
 	var diags diag.Diagnostics
@@ -443,8 +701,29 @@ func convertListToStringSlice(list basetypes.ListValue) ([]string, diag.Diagnost
 	return result, nil
 }
 
+func convertSetToStringSlice(set basetypes.SetValue) ([]string, diag.Diagnostics) {
+	result := []string{}
+	var diags diag.Diagnostics
+
+	// Handle null or unknown values
+	if set.IsNull() || set.IsUnknown() {
+		return result, nil
+	}
+
+	for _, elem := range set.Elements() {
+		strVal, ok := elem.(basetypes.StringValue)
+		if !ok {
+			diags.AddError("Unexpected type", fmt.Sprintf("unexpected type: %T", elem))
+			return nil, diags
+		}
+		result = append(result, strVal.ValueString())
+	}
+
+	return result, nil
+}
+
 func (r *PolicyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var data PolicyModel
+	var data PolicyResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -453,8 +732,16 @@ func (r *PolicyResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
-	// Convert Terraform list of peers to a Go slice
-	sourcePostureChecks, diags := convertListToStringSlice(data.SourcePostureChecks)
+	createTimeout, diags := data.Timeouts.Create(ctx, 0)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := timeoutContext(ctx, createTimeout)
+	defer cancel()
+
+	// Convert Terraform set of posture checks to a Go slice
+	sourcePostureChecks, diags := r.resolveSourcePostureChecks(ctx, &data)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -473,32 +760,16 @@ func (r *PolicyResource) Create(ctx context.Context, req resource.CreateRequest,
 		SourcePostureChecks: &sourcePostureChecks,
 		Rules:               rules,
 	}
-	jsonData, err := json.Marshal(policy)
-	if err != nil {
-		resp.Diagnostics.AddError("JSON Encoding Error", err.Error())
-		return
-	}
-
-	tflog.Info(ctx, string(jsonData[:]))
-	request, err := http.NewRequest("POST", r.client.BaseUrl+"/api/policies", bytes.NewBuffer(jsonData))
-	if err != nil {
-		resp.Diagnostics.AddError("Request Creation Error", err.Error())
-		return
-	}
-	request.Header.Set("Content-Type", "application/json")
-	body, err := r.client.doRequest(request)
+	// Retry failed requests unless they created the policy, which would
+	// otherwise be created twice
+	retryCtx := withPostRetry(ctx, lookupCreatedByName(r.client, "/api/policies", data.Name.ValueString()))
+	createdPolicy, err := postJSON[netbirdApi.Policy](retryCtx, r.client, "/api/policies", policy)
 	if err != nil {
 		resp.Diagnostics.AddError("API Error", err.Error())
 		return
 	}
 
-	var createdPolicy netbirdApi.Policy
-	if err := json.Unmarshal(body, &createdPolicy); err != nil {
-		resp.Diagnostics.AddError("JSON Decoding Error", err.Error())
-		return
-	}
-
-	data, diags = convertPolicyFromApiModel(createdPolicy)
+	data.PolicyModel, diags = convertPolicyFromApiModelLike(*createdPolicy, data.PolicyModel)
 	resp.Diagnostics.Append(diags...)
 	if diags.HasError() {
 		return
@@ -506,10 +777,11 @@ func (r *PolicyResource) Create(ctx context.Context, req resource.CreateRequest,
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIDIdentity(ctx, resp.Identity, data.ID)...)
 }
 
 func (r *PolicyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var data PolicyModel
+	var data PolicyResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -518,33 +790,31 @@ func (r *PolicyResource) Read(ctx context.Context, req resource.ReadRequest, res
 		return
 	}
 
-	// Fetch data from API
-	reqURL := fmt.Sprintf("%s/api/policies/%s", r.client.BaseUrl, data.ID.ValueString())
-	httpReq, err := http.NewRequest("GET", reqURL, nil)
-	if err != nil {
-		resp.Diagnostics.AddError("Error creating request", err.Error())
+	// The framework requires an identity even when the object has been deleted
+	resp.Diagnostics.Append(setIDIdentity(ctx, resp.Identity, data.ID)...)
+
+	readTimeout, diags := data.Timeouts.Read(ctx, 0)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
+	ctx, cancel := timeoutContext(ctx, readTimeout)
+	defer cancel()
 
-	responseBody, err := r.client.doRequest(httpReq)
+	// Fetch data from API
+	responseData, err := getJSON[netbirdApi.Policy](ctx, r.client, fmt.Sprintf("/api/policies/%s", data.ID.ValueString()))
 	if err != nil {
 		resp.Diagnostics.AddError("Error fetching policy", err.Error())
 		return
 	}
 
-	// Handle when resource does not exist
-	if responseBody == nil {
-		data.ID = types.StringNull()
-		return
-	}
-
-	var responseData netbirdApi.Policy
-	if err := json.Unmarshal(responseBody, &responseData); err != nil {
-		resp.Diagnostics.AddError("Error parsing response", err.Error())
+	// The object has been deleted outside of Terraform
+	if responseData == nil {
+		resp.State.RemoveResource(ctx)
 		return
 	}
 
-	data, diags := convertPolicyFromApiModel(responseData)
+	data.PolicyModel, diags = convertPolicyFromApiModelLike(*responseData, data.PolicyModel)
 	resp.Diagnostics.Append(diags...)
 	if diags.HasError() {
 		return
@@ -555,7 +825,7 @@ func (r *PolicyResource) Read(ctx context.Context, req resource.ReadRequest, res
 }
 
 func (r *PolicyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var data PolicyModel
+	var data PolicyResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -564,8 +834,16 @@ func (r *PolicyResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
-	// Convert Terraform list of peers to a Go slice
-	sourcePostureChecks, diags := convertListToStringSlice(data.SourcePostureChecks)
+	updateTimeout, diags := data.Timeouts.Update(ctx, 0)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := timeoutContext(ctx, updateTimeout)
+	defer cancel()
+
+	// Convert Terraform set of posture checks to a Go slice
+	sourcePostureChecks, diags := r.resolveSourcePostureChecks(ctx, &data)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -584,32 +862,20 @@ func (r *PolicyResource) Update(ctx context.Context, req resource.UpdateRequest,
 		SourcePostureChecks: &sourcePostureChecks,
 		Rules:               rules,
 	}
-	jsonData, err := json.Marshal(policy)
-	if err != nil {
-		resp.Diagnostics.AddError("JSON Encoding Error", err.Error())
-		return
-	}
-
-	url := fmt.Sprintf("%s/api/policies/%s", r.client.BaseUrl, data.ID.ValueString())
-	request, err := http.NewRequest("PUT", url, bytes.NewBuffer(jsonData))
-	if err != nil {
-		resp.Diagnostics.AddError("Request Creation Error", err.Error())
-		return
-	}
-	request.Header.Set("Content-Type", "application/json")
-	body, err := r.client.doRequest(request)
+	updatedPolicy, err := putJSON[netbirdApi.Policy](ctx, r.client, fmt.Sprintf("/api/policies/%s", data.ID.ValueString()), policy)
 	if err != nil {
 		resp.Diagnostics.AddError("API Error", err.Error())
 		return
 	}
-
-	var createdPolicy netbirdApi.Policy
-	if err := json.Unmarshal(body, &createdPolicy); err != nil {
-		resp.Diagnostics.AddError("JSON Decoding Error", err.Error())
-		return
+	if updatedPolicy == nil {
+		updatedPolicy, err = requireJSON(getJSON[netbirdApi.Policy](ctx, r.client, fmt.Sprintf("/api/policies/%s", data.ID.ValueString())))
+		if err != nil {
+			resp.Diagnostics.AddError("Error fetching policy", err.Error())
+			return
+		}
 	}
 
-	data, diags = convertPolicyFromApiModel(createdPolicy)
+	data.PolicyModel, diags = convertPolicyFromApiModelLike(*updatedPolicy, data.PolicyModel)
 	resp.Diagnostics.Append(diags...)
 	if diags.HasError() {
 		return
@@ -617,10 +883,11 @@ func (r *PolicyResource) Update(ctx context.Context, req resource.UpdateRequest,
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIDIdentity(ctx, resp.Identity, data.ID)...)
 }
 
 func (r *PolicyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var data PolicyModel
+	var data PolicyResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -629,22 +896,204 @@ func (r *PolicyResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
-	reqURL := fmt.Sprintf("%s/api/policies/%s", r.client.BaseUrl, data.ID.ValueString())
-	httpReq, err := http.NewRequest("DELETE", reqURL, nil)
-	if err != nil {
-		resp.Diagnostics.AddError("Error creating request", err.Error())
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, 0)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
+	ctx, cancel := timeoutContext(ctx, deleteTimeout)
+	defer cancel()
 
-	_, err = r.client.doRequest(httpReq)
+	err := deleteObject(ctx, r.client, fmt.Sprintf("/api/policies/%s", data.ID.ValueString()))
 	if err != nil {
-		resp.Diagnostics.AddError("Error deleting network", err.Error())
+		resp.Diagnostics.AddError("Error deleting policy", err.Error())
 		return
 	}
 
 	resp.State.RemoveResource(ctx)
 }
 
+func (r *PolicyResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	priorSchema := policySchemaV0(ctx)
+
+	return map[int64]resource.StateUpgrader{
+		0: {
+			PriorSchema:   &priorSchema,
+			StateUpgrader: upgradePolicyStateV0,
+		},
+	}
+}
+
+// policySchemaV0 is the frozen schema version 0. It must not follow
+// changes to the current schema, as it describes states already written.
+func policySchemaV0(ctx context.Context) schema.Schema {
+	resourceAttributes := map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Required: true,
+		},
+		"type": schema.StringAttribute{
+			Required: true,
+		},
+	}
+
+	return schema.Schema{
+		Version: 0,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"name": schema.StringAttribute{
+				Required: true,
+			},
+			"description": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+			},
+			"enabled": schema.BoolAttribute{
+				Required: true,
+			},
+			"source_posture_checks": schema.ListAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Computed:    true,
+			},
+			"rules": schema.ListNestedAttribute{
+				Required: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed: true,
+						},
+						"name": schema.StringAttribute{
+							Required: true,
+						},
+						"description": schema.StringAttribute{
+							Optional: true,
+							Computed: true,
+						},
+						"enabled": schema.BoolAttribute{
+							Required: true,
+						},
+						"action": schema.StringAttribute{
+							Required: true,
+						},
+						"bidirectional": schema.BoolAttribute{
+							Required: true,
+						},
+						"protocol": schema.StringAttribute{
+							Required: true,
+						},
+						"ports": schema.ListAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Computed:    true,
+						},
+						"port_ranges": schema.ListNestedAttribute{
+							Optional: true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"start": schema.Int32Attribute{
+										Required: true,
+									},
+									"end": schema.Int32Attribute{
+										Required: true,
+									},
+								},
+							},
+						},
+						"sources": schema.ListAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+						},
+						"destinations": schema.ListAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+						},
+						"source_resource": schema.SingleNestedAttribute{
+							Optional:   true,
+							Attributes: resourceAttributes,
+						},
+						"destination_resource": schema.ListNestedAttribute{
+							Optional: true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: resourceAttributes,
+							},
+						},
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func upgradePolicyStateV0(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+	var prior policyResourceModelV0
+	resp.Diagnostics.Append(req.State.Get(ctx, &prior)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	sourcePostureChecks, diags := listToSetValue(ctx, prior.SourcePostureChecks)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data := PolicyResourceModel{
+		PolicyModel: PolicyModel{
+			ID:                  prior.ID,
+			Name:                prior.Name,
+			Description:         prior.Description,
+			Enabled:             prior.Enabled,
+			SourcePostureChecks: sourcePostureChecks,
+			Rules:               upgradePolicyRulesV0(prior.Rules),
+		},
+		SourcePostureCheckNames: types.SetNull(types.StringType),
+		Timeouts:                prior.Timeouts,
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// upgradePolicyRulesV0 converts version 0 rules, taking the first
+// destination_resource element as the rule destination resource.
+func upgradePolicyRulesV0(prior []policyRuleModelV0) []PolicyRuleModel {
+	if prior == nil {
+		return nil
+	}
+
+	rules := make([]PolicyRuleModel, 0, len(prior))
+	for _, rule := range prior {
+		var destinationResource *ResourceModel
+		if len(rule.DestinationResource) > 0 {
+			destinationResource = &rule.DestinationResource[0]
+		}
+		rules = append(rules, PolicyRuleModel{
+			ID:                  rule.ID,
+			Name:                rule.Name,
+			Description:         rule.Description,
+			Enabled:             rule.Enabled,
+			Action:              rule.Action,
+			Bidirectional:       rule.Bidirectional,
+			Protocol:            rule.Protocol,
+			Ports:               rule.Ports,
+			PortRanges:          rule.PortRanges,
+			Sources:             rule.Sources,
+			Destinations:        rule.Destinations,
+			SourceResource:      rule.SourceResource,
+			DestinationResource: destinationResource,
+		})
+	}
+	return rules
+}
+
 func (r *PolicyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
 }
@@ -0,0 +1,510 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccPolicyResource(t *testing.T) {
+	providerConfig, mock := testAccProviderConfig(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckDestroy(mock, "netbird_policy", staticPath("/api/policies")),
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + testAccPolicyResourceConfig(true, "80"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("netbird_policy.test", "name", "tf-acc-policy"),
+					resource.TestCheckResourceAttr("netbird_policy.test", "enabled", "true"),
+					resource.TestCheckResourceAttr("netbird_policy.test", "rules.#", "1"),
+					resource.TestCheckResourceAttr("netbird_policy.test", "rules.0.name", "tf-acc-rule"),
+					resource.TestCheckResourceAttr("netbird_policy.test", "rules.0.bidirectional", "true"),
+					resource.TestCheckResourceAttr("netbird_policy.test", "rules.0.ports.#", "1"),
+					resource.TestCheckResourceAttr("netbird_policy.test", "rules.0.ports.0", "80"),
+					resource.TestCheckResourceAttrPair("netbird_policy.test", "rules.0.sources.0", "netbird_group.source", "id"),
+					resource.TestCheckResourceAttrPair("netbird_policy.test", "rules.0.destinations.0", "netbird_group.destination", "id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "netbird_policy.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + testAccPolicyResourceConfig(false, "443"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("netbird_policy.test", "enabled", "false"),
+					resource.TestCheckResourceAttr("netbird_policy.test", "rules.0.ports.0", "443"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccPolicyResourceConfig(enabled bool, port string) string {
+	return fmt.Sprintf(`
+resource "netbird_group" "source" {
+  name = "tf-acc-policy-source"
+}
+
+resource "netbird_group" "destination" {
+  name = "tf-acc-policy-destination"
+}
+
+resource "netbird_policy" "test" {
+  name    = "tf-acc-policy"
+  enabled = %t
+  rules = [
+    {
+      name         = "tf-acc-rule"
+      enabled      = true
+      action       = "accept"
+      protocol     = "tcp"
+      ports        = [%q]
+      sources      = [netbird_group.source.id]
+      destinations = [netbird_group.destination.id]
+    }
+  ]
+}
+`, enabled, port)
+}
+
+func TestAccPolicyResource_ruleOrder(t *testing.T) {
+	providerConfig, mock := testAccProviderConfig(t)
+
+	ruleNames := func(names ...string) knownvalue.Check {
+		checks := make([]knownvalue.Check, len(names))
+		for i, name := range names {
+			checks[i] = knownvalue.ObjectPartial(map[string]knownvalue.Check{"name": knownvalue.StringExact(name)})
+		}
+		return knownvalue.ListExact(checks)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckDestroy(mock, "netbird_policy", staticPath("/api/policies")),
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + testAccPolicyResourceRuleOrderConfig("ssh", "http", "dns"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("netbird_policy.test", tfjsonpath.New("rules"), ruleNames("ssh", "http", "dns")),
+				},
+			},
+			// Reordering the rules is an in-place update to the new order
+			{
+				Config: providerConfig + testAccPolicyResourceRuleOrderConfig("dns", "ssh", "http"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("netbird_policy.test", plancheck.ResourceActionUpdate),
+						plancheck.ExpectKnownValue("netbird_policy.test", tfjsonpath.New("rules"), ruleNames("dns", "ssh", "http")),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("netbird_policy.test", tfjsonpath.New("rules"), ruleNames("dns", "ssh", "http")),
+					statecheck.ExpectKnownValue("netbird_policy.test", tfjsonpath.New("rules").AtSliceIndex(0).AtMapKey("ports"),
+						knownvalue.ListExact([]knownvalue.Check{knownvalue.StringExact("53")})),
+				},
+			},
+			// and the order read back from the API does not cause a diff
+			{
+				Config: providerConfig + testAccPolicyResourceRuleOrderConfig("dns", "ssh", "http"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
+
+func testAccPolicyResourceRuleOrderConfig(ruleNames ...string) string {
+	ports := map[string]string{"ssh": "22", "http": "80", "dns": "53"}
+	rules := ""
+	for _, name := range ruleNames {
+		rules += fmt.Sprintf(`
+    {
+      name          = %q
+      enabled       = true
+      action        = "accept"
+      bidirectional = false
+      protocol      = "tcp"
+      ports         = [%q]
+      sources       = [netbird_group.test.id]
+      destinations  = [netbird_group.test.id]
+    },`, name, ports[name])
+	}
+
+	return fmt.Sprintf(`
+resource "netbird_group" "test" {
+  name = "tf-acc-policy-rule-order"
+}
+
+resource "netbird_policy" "test" {
+  name    = "tf-acc-policy-rule-order"
+  enabled = true
+  rules = [%s
+  ]
+}
+`, rules)
+}
+
+func TestAccPolicyResource_validateReferences(t *testing.T) {
+	providerConfig, mock := testAccProviderConfig(t)
+	groupID := mock.Seed("/api/groups", map[string]any{"name": "tf-acc-existing"})
+	postureCheckID := mock.Seed("/api/posture-checks", map[string]any{"name": "tf-acc-posture-check", "checks": map[string]any{}})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckDestroy(mock, "netbird_policy", staticPath("/api/policies")),
+		Steps: []resource.TestStep{
+			{
+				Config:      providerConfig + testAccPolicyResourceReferencesConfig(true, groupID, "missing-group", postureCheckID),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`(?s)Unknown group IDs.*missing-group`),
+			},
+			{
+				Config:      providerConfig + testAccPolicyResourceReferencesConfig(true, groupID, groupID, "missing-check"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`(?s)Unknown posture check IDs.*missing-check`),
+			},
+			// Without the flag stale IDs are left to the API
+			{
+				Config:             providerConfig + testAccPolicyResourceReferencesConfig(false, groupID, "missing-group", postureCheckID),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			// Groups created in the same apply are only known after apply
+			{
+				Config: providerConfig + testAccPolicyResourceReferencesConfig(true, groupID, "${netbird_group.new.id}", postureCheckID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("netbird_policy.test", "validate_references", "true"),
+					resource.TestCheckResourceAttrPair("netbird_policy.test", "rules.0.destinations.0", "netbird_group.new", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccPolicyResourceReferencesConfig(validate bool, source, destination, postureCheck string) string {
+	return fmt.Sprintf(`
+resource "netbird_group" "new" {
+  name = "tf-acc-policy-new"
+}
+
+resource "netbird_policy" "test" {
+  name                  = "tf-acc-policy"
+  enabled               = true
+  validate_references   = %t
+  source_posture_checks = [%q]
+  rules = [
+    {
+      name          = "tf-acc-rule"
+      enabled       = true
+      action        = "accept"
+      bidirectional = true
+      protocol      = "all"
+      sources       = [%q]
+      destinations  = [%q]
+    }
+  ]
+}
+`, validate, postureCheck, source, destination)
+}
+
+func TestAccPolicyResource_sourcePostureCheckNames(t *testing.T) {
+	providerConfig, mock := testAccProviderConfig(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckDestroy(mock, "netbird_policy", staticPath("/api/policies")),
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + testAccPolicyResourcePostureCheckNamesConfig(`
+  source_posture_checks      = [netbird_posture_check.network.id]
+  source_posture_check_names = [netbird_posture_check.network.name]
+`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("Conflicting source posture checks"),
+			},
+			{
+				Config: providerConfig + testAccPolicyResourcePostureCheckNamesConfig(`source_posture_check_names = [netbird_posture_check.version.name]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("netbird_policy.test", "source_posture_check_names.#", "1"),
+					resource.TestCheckResourceAttr("netbird_policy.test", "source_posture_checks.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair("netbird_policy.test", "source_posture_checks.*", "netbird_posture_check.version", "id"),
+				),
+			},
+			{
+				Config: providerConfig + testAccPolicyResourcePostureCheckNamesConfig(`source_posture_check_names = [netbird_posture_check.version.name, netbird_posture_check.network.name]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("netbird_policy.test", "source_posture_checks.#", "2"),
+					resource.TestCheckTypeSetElemAttrPair("netbird_policy.test", "source_posture_checks.*", "netbird_posture_check.version", "id"),
+					resource.TestCheckTypeSetElemAttrPair("netbird_policy.test", "source_posture_checks.*", "netbird_posture_check.network", "id"),
+				),
+			},
+			// Switching to IDs keeps the same posture checks
+			{
+				Config: providerConfig + testAccPolicyResourcePostureCheckNamesConfig(`source_posture_checks = [netbird_posture_check.network.id]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("netbird_policy.test", "source_posture_check_names.#"),
+					resource.TestCheckResourceAttr("netbird_policy.test", "source_posture_checks.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair("netbird_policy.test", "source_posture_checks.*", "netbird_posture_check.network", "id"),
+				),
+			},
+			{
+				Config:      providerConfig + testAccPolicyResourcePostureCheckNamesConfig(`source_posture_check_names = ["tf-acc-missing"]`),
+				ExpectError: regexp.MustCompile("no posture check found with name: tf-acc-missing"),
+			},
+		},
+	})
+}
+
+func testAccPolicyResourcePostureCheckNamesConfig(postureChecks string) string {
+	return fmt.Sprintf(`
+resource "netbird_group" "test" {
+  name = "tf-acc-policy-posture-checks"
+}
+
+resource "netbird_posture_check" "version" {
+  name = "tf-acc-require-nb-0.35"
+  nb_version_check = {
+    min_version = "0.35.0"
+  }
+}
+
+resource "netbird_posture_check" "network" {
+  name = "tf-acc-block-network"
+  peer_network_range_check = {
+    action = "deny"
+    ranges = ["192.0.2.0/24"]
+  }
+}
+
+resource "netbird_policy" "test" {
+  name    = "tf-acc-policy"
+  enabled = true
+  %s
+  rules = [
+    {
+      name         = "tf-acc-rule"
+      enabled      = true
+      action       = "accept"
+      protocol     = "all"
+      sources      = [netbird_group.test.id]
+      destinations = [netbird_group.test.id]
+    }
+  ]
+}
+`, postureChecks)
+}
+
+func TestAccPolicyResource_upgradeFromV0(t *testing.T) {
+	providerConfig, mock := testAccProviderConfig(t)
+	workingDir := t.TempDir()
+	config := providerConfig + testAccPolicyResourcePostureCheckNamesConfig(`source_posture_checks = [netbird_posture_check.version.id, netbird_posture_check.network.id]`)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckDestroy(mock, "netbird_policy", staticPath("/api/policies")),
+		WorkingDir:               workingDir,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+			},
+			// Rewrite the state as schema version 0 stored it, and check
+			// the upgraded state matches the configuration without changes.
+			{
+				PreConfig: func() { testAccDowngradePolicyStateV0(t, workingDir) },
+				Config:    config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("netbird_policy.test", "name", "tf-acc-policy"),
+					resource.TestCheckResourceAttr("netbird_policy.test", "source_posture_checks.#", "2"),
+					resource.TestCheckTypeSetElemAttrPair("netbird_policy.test", "source_posture_checks.*", "netbird_posture_check.version", "id"),
+					resource.TestCheckTypeSetElemAttrPair("netbird_policy.test", "source_posture_checks.*", "netbird_posture_check.network", "id"),
+					resource.TestCheckNoResourceAttr("netbird_policy.test", "source_posture_check_names.#"),
+					resource.TestCheckResourceAttr("netbird_policy.test", "rules.#", "1"),
+					resource.TestCheckResourceAttr("netbird_policy.test", "rules.0.protocol", "all"),
+					resource.TestCheckNoResourceAttr("netbird_policy.test", "rules.0.destination_resource.id"),
+				),
+			},
+		},
+	})
+}
+
+// testAccDowngradePolicyStateV0 rewrites the netbird_policy instances in
+// the test state below workingDir to schema version 0, dropping attributes
+// that version did not have.
+func testAccDowngradePolicyStateV0(t *testing.T, workingDir string) {
+	t.Helper()
+
+	statePaths, err := filepath.Glob(filepath.Join(workingDir, "work*", "terraform.tfstate"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(statePaths) != 1 {
+		t.Fatalf("expected one state file in %s, got %v", workingDir, statePaths)
+	}
+	statePath := statePaths[0]
+
+	raw, err := os.ReadFile(statePath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var state map[string]any
+	if err := json.Unmarshal(raw, &state); err != nil {
+		t.Fatal(err)
+	}
+
+	downgraded := 0
+	for _, r := range state["resources"].([]any) {
+		res := r.(map[string]any)
+		if res["type"] != "netbird_policy" {
+			continue
+		}
+		for _, i := range res["instances"].([]any) {
+			instance := i.(map[string]any)
+			instance["schema_version"] = 0
+			attributes := instance["attributes"].(map[string]any)
+			delete(attributes, "validate_references")
+			delete(attributes, "source_posture_check_names")
+			downgraded++
+		}
+	}
+	if downgraded == 0 {
+		t.Fatalf("no netbird_policy instances in %s", statePath)
+	}
+	state["serial"] = state["serial"].(float64) + 1
+
+	raw, err = json.MarshalIndent(state, "", "  ")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(statePath, raw, 0o600); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestPolicyResourceUpgradeStateV0(t *testing.T) {
+	ctx := context.Background()
+	server, err := providerserver.NewProtocol6WithError(New("test")())()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for name, tc := range map[string]struct {
+		sourcePostureChecks string
+		destinationResource string
+		want                []string
+	}{
+		"list":                 {`["pc1", "pc2"]`, `null`, []string{"pc1", "pc2"}},
+		"duplicates":           {`["pc1", "pc2", "pc1"]`, `null`, []string{"pc1", "pc2"}},
+		"empty":                {`[]`, `null`, []string{}},
+		"null":                 {`null`, `null`, nil},
+		"destination resource": {`null`, `[{"id": "res1", "type": "host"}]`, nil},
+	} {
+		t.Run(name, func(t *testing.T) {
+			rawState := fmt.Sprintf(`{
+  "id": "p1",
+  "name": "web",
+  "description": "",
+  "enabled": true,
+  "source_posture_checks": %s,
+  "rules": [{
+    "id": "r1",
+    "name": "web",
+    "description": "",
+    "enabled": true,
+    "action": "accept",
+    "bidirectional": true,
+    "protocol": "tcp",
+    "ports": ["80"],
+    "port_ranges": null,
+    "sources": ["g1"],
+    "destinations": ["g2"],
+    "source_resource": null,
+    "destination_resource": %s
+  }]
+}`, tc.sourcePostureChecks, tc.destinationResource)
+			resp, err := server.UpgradeResourceState(ctx, &tfprotov6.UpgradeResourceStateRequest{
+				TypeName: "netbird_policy",
+				Version:  0,
+				RawState: &tfprotov6.RawState{JSON: []byte(rawState)},
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, d := range resp.Diagnostics {
+				t.Errorf("unexpected diagnostic: %s: %s", d.Summary, d.Detail)
+			}
+			if t.Failed() {
+				return
+			}
+
+			var schemaResp fwresource.SchemaResponse
+			NewPolicyResource().Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+			raw, err := resp.UpgradedState.Unmarshal(schemaResp.Schema.Type().TerraformType(ctx))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var data PolicyResourceModel
+			state := tfsdk.State{Schema: schemaResp.Schema, Raw: raw}
+			if diags := state.Get(ctx, &data); diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+
+			if tc.want == nil {
+				if !data.SourcePostureChecks.IsNull() {
+					t.Errorf("expected source_posture_checks to stay null, got %s", data.SourcePostureChecks)
+				}
+			} else {
+				sourcePostureChecks := []string{}
+				data.SourcePostureChecks.ElementsAs(ctx, &sourcePostureChecks, false)
+				slices.Sort(sourcePostureChecks)
+				if !slices.Equal(sourcePostureChecks, tc.want) {
+					t.Errorf("expected source_posture_checks %v, got %v", tc.want, sourcePostureChecks)
+				}
+			}
+			if data.ID.ValueString() != "p1" || len(data.Rules) != 1 || data.Rules[0].Protocol.ValueString() != "tcp" {
+				t.Errorf("expected other attributes to be kept, got %+v", data)
+			}
+			if tc.destinationResource == `null` {
+				if data.Rules[0].DestinationResource != nil {
+					t.Errorf("expected destination_resource to stay null, got %+v", data.Rules[0].DestinationResource)
+				}
+			} else if dr := data.Rules[0].DestinationResource; dr == nil || dr.ID.ValueString() != "res1" || dr.Type.ValueString() != "host" {
+				t.Errorf("expected destination_resource res1 of type host, got %+v", dr)
+			}
+			if !data.Timeouts.Object.IsNull() {
+				t.Errorf("expected timeouts to be null, got %s", data.Timeouts)
+			}
+		})
+	}
+}
@@ -0,0 +1,78 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &PortsFunction{}
+
+func NewPortsFunction() function.Function {
+	return &PortsFunction{}
+}
+
+// PortsFunction expands port ranges into the individual ports of a policy rule.
+type PortsFunction struct{}
+
+func (f *PortsFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "ports"
+}
+
+func (f *PortsFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Expand port ranges into a list of ports",
+		MarkdownDescription: "Expands ports and inclusive port ranges, e.g. `[\"80\", \"443\", \"8000-8010\"]`, into the list of individual ports " +
+			"accepted by the `ports` of a `netbird_policy` rule. Duplicate ports are removed, keeping the order of first appearance.",
+		Parameters: []function.Parameter{
+			function.ListParameter{
+				Name:                "ranges",
+				MarkdownDescription: "Ports such as `443` and ranges such as `8000-8010`",
+				ElementType:         types.StringType,
+			},
+		},
+		Return: function.ListReturn{
+			ElementType: types.StringType,
+		},
+	}
+}
+
+func (f *PortsFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var ranges []string
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &ranges))
+	if resp.Error != nil {
+		return
+	}
+
+	ports, err := expandPorts(ranges)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, ports))
+}
+
+// expandPorts expands ports and port ranges into individual ports, without duplicates.
+func expandPorts(ranges []string) ([]string, error) {
+	ports := []string{}
+	seen := map[int]bool{}
+	for i, value := range ranges {
+		start, end, err := parsePortRange(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid port at index %d: %s", i, err)
+		}
+		for port := start; port <= end; port++ {
+			if !seen[port] {
+				seen[port] = true
+				ports = append(ports, strconv.Itoa(port))
+			}
+		}
+	}
+	return ports, nil
+}
@@ -0,0 +1,96 @@
+package provider
+
+import (
+	"context"
+	"regexp"
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestExpandPorts(t *testing.T) {
+	ports, err := expandPorts([]string{"443", "80", "8000-8003", "443", "8002-8004"})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if want := []string{"443", "80", "8000", "8001", "8002", "8003", "8004"}; !slices.Equal(ports, want) {
+		t.Errorf("expected %v, got %v", want, ports)
+	}
+
+	if ports, err := expandPorts(nil); err != nil || len(ports) != 0 {
+		t.Errorf("expected no ports, got %v %v", ports, err)
+	}
+
+	for _, value := range []string{"", "http", "0", "65536", "-80", "80-", "8010-8000", "1-2-3", "+80"} {
+		if _, err := expandPorts([]string{"22", value}); err == nil {
+			t.Errorf("%q: expected an error", value)
+		}
+	}
+}
+
+func TestPortsFunctionRun(t *testing.T) {
+	ranges := types.ListValueMust(types.StringType, []attr.Value{types.StringValue("22"), types.StringValue("8000-8001")})
+	req := function.RunRequest{Arguments: function.NewArgumentsData([]attr.Value{ranges})}
+	resp := &function.RunResponse{Result: function.NewResultData(types.ListUnknown(types.StringType))}
+
+	NewPortsFunction().Run(context.Background(), req, resp)
+
+	if resp.Error != nil {
+		t.Fatalf("unexpected error: %s", resp.Error)
+	}
+	want := types.ListValueMust(types.StringType, []attr.Value{types.StringValue("22"), types.StringValue("8000"), types.StringValue("8001")})
+	if got := resp.Result.Value(); !got.Equal(want) {
+		t.Errorf("expected %s, got %s", want, got)
+	}
+
+	req = function.RunRequest{Arguments: function.NewArgumentsData([]attr.Value{
+		types.ListValueMust(types.StringType, []attr.Value{types.StringValue("443-80")}),
+	})}
+	resp = &function.RunResponse{Result: function.NewResultData(types.ListUnknown(types.StringType))}
+	NewPortsFunction().Run(context.Background(), req, resp)
+	if resp.Error == nil || resp.Error.FunctionArgument == nil || *resp.Error.FunctionArgument != 0 {
+		t.Errorf("expected an argument error, got %v", resp.Error)
+	}
+}
+
+func TestAccPortsFunction(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+output "ports" {
+  value = provider::netbird::ports(["80", "443", "8000-8002"])
+}
+`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("ports", knownvalue.ListExact([]knownvalue.Check{
+						knownvalue.StringExact("80"),
+						knownvalue.StringExact("443"),
+						knownvalue.StringExact("8000"),
+						knownvalue.StringExact("8001"),
+						knownvalue.StringExact("8002"),
+					})),
+				},
+			},
+			{
+				Config: `
+output "ports" {
+  value = provider::netbird::ports(["8010-8000"])
+}
+`,
+				ExpectError: regexp.MustCompile("inverted"),
+			},
+		},
+	})
+}
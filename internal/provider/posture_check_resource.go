@@ -0,0 +1,608 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	netbirdApi "github.com/netbirdio/netbird/management/server/http/api"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &PostureCheckResource{}
+var _ resource.ResourceWithImportState = &PostureCheckResource{}
+var _ resource.ResourceWithIdentity = &PostureCheckResource{}
+var _ resource.ResourceWithConfigValidators = &PostureCheckResource{}
+
+func NewPostureCheckResource() resource.Resource {
+	return &PostureCheckResource{}
+}
+
+// PostureCheckResource defines the resource implementation.
+type PostureCheckResource struct {
+	client ClientInterface
+}
+
+type PostureCheckResourceModel struct {
+	ID                    types.String                        `tfsdk:"id"`
+	Name                  types.String                        `tfsdk:"name"`
+	Description           types.String                        `tfsdk:"description"`
+	NbVersionCheck        *MinVersionCheckModel               `tfsdk:"nb_version_check"`
+	OsVersionCheck        *OSVersionCheckModel                `tfsdk:"os_version_check"`
+	PeerNetworkRangeCheck *PeerNetworkRangeCheckResourceModel `tfsdk:"peer_network_range_check"`
+	ProcessCheck          *ProcessCheckModel                  `tfsdk:"process_check"`
+	GeoLocationCheck      *GeoLocationCheckModel              `tfsdk:"geo_location_check"`
+}
+
+type MinVersionCheckModel struct {
+	MinVersion types.String `tfsdk:"min_version"`
+}
+
+type MinKernelVersionCheckModel struct {
+	MinKernelVersion types.String `tfsdk:"min_kernel_version"`
+}
+
+type OSVersionCheckModel struct {
+	Android *MinVersionCheckModel       `tfsdk:"android"`
+	Darwin  *MinVersionCheckModel       `tfsdk:"darwin"`
+	Ios     *MinVersionCheckModel       `tfsdk:"ios"`
+	Linux   *MinKernelVersionCheckModel `tfsdk:"linux"`
+	Windows *MinKernelVersionCheckModel `tfsdk:"windows"`
+}
+
+type PeerNetworkRangeCheckResourceModel struct {
+	Action types.String `tfsdk:"action"`
+	Ranges types.List   `tfsdk:"ranges"`
+}
+
+type ProcessCheckModel struct {
+	Processes []ProcessModel `tfsdk:"processes"`
+}
+
+type ProcessModel struct {
+	LinuxPath   types.String `tfsdk:"linux_path"`
+	MacPath     types.String `tfsdk:"mac_path"`
+	WindowsPath types.String `tfsdk:"windows_path"`
+}
+
+type GeoLocationCheckModel struct {
+	Action    types.String    `tfsdk:"action"`
+	Locations []LocationModel `tfsdk:"locations"`
+}
+
+type LocationModel struct {
+	CountryCode types.String `tfsdk:"country_code"`
+	CityName    types.String `tfsdk:"city_name"`
+}
+
+// postureCheckChecks are the attributes holding the checks of a posture
+// check, of which any combination can be set.
+var postureCheckChecks = []string{"nb_version_check", "os_version_check", "peer_network_range_check", "process_check", "geo_location_check"}
+
+func minVersionCheckAttribute(description string) schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Optional:            true,
+		MarkdownDescription: description,
+		Attributes: map[string]schema.Attribute{
+			"min_version": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Minimum acceptable version, e.g. `0.43.0`",
+				Validators: []validator.String{
+					versionValidator{},
+				},
+			},
+		},
+	}
+}
+
+func processPathAttribute(description string) schema.StringAttribute {
+	return schema.StringAttribute{
+		Optional:            true,
+		MarkdownDescription: description,
+		Validators: []validator.String{
+			processPathValidator,
+		},
+	}
+}
+
+func minKernelVersionCheckAttribute(description string) schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Optional:            true,
+		MarkdownDescription: description,
+		Attributes: map[string]schema.Attribute{
+			"min_kernel_version": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Minimum acceptable kernel version, e.g. `5.15.0`",
+				Validators: []validator.String{
+					versionValidator{},
+				},
+			},
+		},
+	}
+}
+
+func (r *PostureCheckResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_posture_check"
+}
+
+func (r *PostureCheckResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Posture check resource. A peer passes the posture check when it passes every check that is set, " +
+			"so checks of different types can be combined in one posture check. At least one check must be set.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Posture check ID",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Posture check name",
+			},
+			"description": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Posture check description",
+				Default:             stringdefault.StaticString(""),
+			},
+			"nb_version_check": minVersionCheckAttribute("Requires a minimum NetBird client version"),
+			"os_version_check": schema.SingleNestedAttribute{
+				Optional:            true,
+				MarkdownDescription: "Requires a minimum operating system version. Operating systems which are not set are not allowed.",
+				Attributes: map[string]schema.Attribute{
+					"android": minVersionCheckAttribute("Minimum Android version"),
+					"darwin":  minVersionCheckAttribute("Minimum macOS version"),
+					"ios":     minVersionCheckAttribute("Minimum iOS version"),
+					"linux":   minKernelVersionCheckAttribute("Minimum Linux kernel version"),
+					"windows": minKernelVersionCheckAttribute("Minimum Windows kernel version"),
+				},
+			},
+			"peer_network_range_check": schema.SingleNestedAttribute{
+				Optional:            true,
+				MarkdownDescription: "Allows or denies peers based on the network ranges of their local addresses",
+				Attributes: map[string]schema.Attribute{
+					"action": schema.StringAttribute{
+						Required:            true,
+						MarkdownDescription: "Either `allow` or `deny` peers with an address in `ranges`",
+						Validators: []validator.String{
+							postureCheckActionValidator,
+						},
+					},
+					"ranges": schema.ListAttribute{
+						Required:            true,
+						ElementType:         types.StringType,
+						MarkdownDescription: "Network ranges in CIDR format, e.g. `192.168.0.0/16`",
+						Validators: []validator.List{
+							cidrListValidator{},
+						},
+					},
+				},
+			},
+			"process_check": schema.SingleNestedAttribute{
+				Optional:            true,
+				MarkdownDescription: "Requires processes to be running on peers",
+				Attributes: map[string]schema.Attribute{
+					"processes": schema.ListNestedAttribute{
+						Required: true,
+						MarkdownDescription: "Processes which must all be running. A process is only checked on the operating systems " +
+							"it has a path for, so a peer on another operating system fails the check.",
+						Validators: []validator.List{
+							processListValidator{},
+						},
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"linux_path":   processPathAttribute("Path to the executable of the process on Linux, e.g. `/usr/bin/falcon-sensor`"),
+								"mac_path":     processPathAttribute("Path to the executable of the process on macOS, e.g. `/Applications/Falcon.app/Contents/Resources/falcond`"),
+								"windows_path": processPathAttribute("Path to the executable of the process on Windows, e.g. `C:\\Program Files\\CrowdStrike\\CSFalconService.exe`"),
+							},
+						},
+					},
+				},
+			},
+			"geo_location_check": schema.SingleNestedAttribute{
+				Optional:            true,
+				MarkdownDescription: "Allows or denies peers based on the location of their public IP address",
+				Attributes: map[string]schema.Attribute{
+					"action": schema.StringAttribute{
+						Required:            true,
+						MarkdownDescription: "Either `allow` or `deny` peers in one of `locations`",
+						Validators: []validator.String{
+							postureCheckActionValidator,
+						},
+					},
+					"locations": schema.ListNestedAttribute{
+						Required:            true,
+						MarkdownDescription: "Countries, or cities within them, the action applies to",
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"country_code": schema.StringAttribute{
+									Required:            true,
+									MarkdownDescription: "ISO 3166-1 alpha-2 code of the country, e.g. `DE`",
+									Validators: []validator.String{
+										countryCodeValidator,
+									},
+								},
+								"city_name": schema.StringAttribute{
+									Optional:            true,
+									MarkdownDescription: "English name of a city in the country, e.g. `Berlin`. The whole country when not set",
+									Validators: []validator.String{
+										cityNameValidator,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *PostureCheckResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		postureCheckChecksValidator{},
+	}
+}
+
+// postureCheckChecksValidator checks that a posture check has at least one check.
+type postureCheckChecksValidator struct{}
+
+func (v postureCheckChecksValidator) Description(ctx context.Context) string {
+	return fmt.Sprintf("at least one of %s must be set", strings.Join(postureCheckChecks, ", "))
+}
+
+func (v postureCheckChecksValidator) MarkdownDescription(ctx context.Context) string {
+	return fmt.Sprintf("at least one of `%s` must be set", strings.Join(postureCheckChecks, "`, `"))
+}
+
+func (v postureCheckChecksValidator) ValidateResource(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	for _, name := range postureCheckChecks {
+		var check types.Object
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(name), &check)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		// Values may not be known until apply
+		if check.IsUnknown() || !check.IsNull() {
+			return
+		}
+	}
+
+	resp.Diagnostics.AddError(
+		"Missing posture check",
+		fmt.Sprintf("A posture check must check something: %s.", v.Description(ctx)),
+	)
+}
+
+func (r *PostureCheckResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentitySchema("posture check")
+}
+
+func (r *PostureCheckResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(ClientInterface)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func minVersionCheckToApi(model *MinVersionCheckModel) *netbirdApi.MinVersionCheck {
+	if model == nil {
+		return nil
+	}
+	return &netbirdApi.MinVersionCheck{MinVersion: model.MinVersion.ValueString()}
+}
+
+func minKernelVersionCheckToApi(model *MinKernelVersionCheckModel) *netbirdApi.MinKernelVersionCheck {
+	if model == nil {
+		return nil
+	}
+	return &netbirdApi.MinKernelVersionCheck{MinKernelVersion: model.MinKernelVersion.ValueString()}
+}
+
+// postureCheckModelToApi converts every check that is set, leaving the
+// others out of the request.
+func postureCheckModelToApi(data PostureCheckResourceModel) (netbirdApi.PostureCheckUpdate, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	checks := netbirdApi.Checks{
+		NbVersionCheck: minVersionCheckToApi(data.NbVersionCheck),
+	}
+
+	if data.OsVersionCheck != nil {
+		checks.OsVersionCheck = &netbirdApi.OSVersionCheck{
+			Android: minVersionCheckToApi(data.OsVersionCheck.Android),
+			Darwin:  minVersionCheckToApi(data.OsVersionCheck.Darwin),
+			Ios:     minVersionCheckToApi(data.OsVersionCheck.Ios),
+			Linux:   minKernelVersionCheckToApi(data.OsVersionCheck.Linux),
+			Windows: minKernelVersionCheckToApi(data.OsVersionCheck.Windows),
+		}
+	}
+
+	if data.PeerNetworkRangeCheck != nil {
+		ranges, rangeDiags := convertListToStringSlice(data.PeerNetworkRangeCheck.Ranges)
+		diags.Append(rangeDiags...)
+		checks.PeerNetworkRangeCheck = &netbirdApi.PeerNetworkRangeCheck{
+			Action: netbirdApi.PeerNetworkRangeCheckAction(data.PeerNetworkRangeCheck.Action.ValueString()),
+			Ranges: ranges,
+		}
+	}
+
+	if data.ProcessCheck != nil {
+		processes := []netbirdApi.Process{}
+		for _, process := range data.ProcessCheck.Processes {
+			processes = append(processes, netbirdApi.Process{
+				LinuxPath:   process.LinuxPath.ValueStringPointer(),
+				MacPath:     process.MacPath.ValueStringPointer(),
+				WindowsPath: process.WindowsPath.ValueStringPointer(),
+			})
+		}
+		checks.ProcessCheck = &netbirdApi.ProcessCheck{Processes: processes}
+	}
+
+	if data.GeoLocationCheck != nil {
+		locations := []netbirdApi.Location{}
+		for _, location := range data.GeoLocationCheck.Locations {
+			locations = append(locations, netbirdApi.Location{
+				CountryCode: location.CountryCode.ValueString(),
+				CityName:    location.CityName.ValueStringPointer(),
+			})
+		}
+		checks.GeoLocationCheck = &netbirdApi.GeoLocationCheck{
+			Action:    netbirdApi.GeoLocationCheckAction(data.GeoLocationCheck.Action.ValueString()),
+			Locations: locations,
+		}
+	}
+
+	return netbirdApi.PostureCheckUpdate{
+		Name:        data.Name.ValueString(),
+		Description: data.Description.ValueString(),
+		Checks:      &checks,
+	}, diags
+}
+
+func minVersionCheckToModel(check *netbirdApi.MinVersionCheck) *MinVersionCheckModel {
+	if check == nil {
+		return nil
+	}
+	return &MinVersionCheckModel{MinVersion: types.StringValue(check.MinVersion)}
+}
+
+func minKernelVersionCheckToModel(check *netbirdApi.MinKernelVersionCheck) *MinKernelVersionCheckModel {
+	if check == nil {
+		return nil
+	}
+	return &MinKernelVersionCheckModel{MinKernelVersion: types.StringValue(check.MinKernelVersion)}
+}
+
+// postureCheckToModel sets every check from the API, so checks added or
+// removed outside of Terraform show up as a diff.
+func postureCheckToModel(postureCheck netbirdApi.PostureCheck, data *PostureCheckResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	data.ID = types.StringValue(postureCheck.Id)
+	data.Name = types.StringValue(postureCheck.Name)
+	data.Description = types.StringValue("")
+	if postureCheck.Description != nil {
+		data.Description = types.StringValue(*postureCheck.Description)
+	}
+
+	checks := postureCheck.Checks
+	data.NbVersionCheck = minVersionCheckToModel(checks.NbVersionCheck)
+
+	data.OsVersionCheck = nil
+	if checks.OsVersionCheck != nil {
+		data.OsVersionCheck = &OSVersionCheckModel{
+			Android: minVersionCheckToModel(checks.OsVersionCheck.Android),
+			Darwin:  minVersionCheckToModel(checks.OsVersionCheck.Darwin),
+			Ios:     minVersionCheckToModel(checks.OsVersionCheck.Ios),
+			Linux:   minKernelVersionCheckToModel(checks.OsVersionCheck.Linux),
+			Windows: minKernelVersionCheckToModel(checks.OsVersionCheck.Windows),
+		}
+	}
+
+	data.PeerNetworkRangeCheck = nil
+	if checks.PeerNetworkRangeCheck != nil {
+		ranges, rangeDiags := convertStringSliceToListValue(checks.PeerNetworkRangeCheck.Ranges)
+		diags.Append(rangeDiags...)
+		if ranges.IsNull() {
+			ranges = types.ListValueMust(types.StringType, []attr.Value{})
+		}
+		data.PeerNetworkRangeCheck = &PeerNetworkRangeCheckResourceModel{
+			Action: types.StringValue(string(checks.PeerNetworkRangeCheck.Action)),
+			Ranges: ranges,
+		}
+	}
+
+	data.ProcessCheck = nil
+	if checks.ProcessCheck != nil {
+		data.ProcessCheck = &ProcessCheckModel{Processes: []ProcessModel{}}
+		for _, process := range checks.ProcessCheck.Processes {
+			data.ProcessCheck.Processes = append(data.ProcessCheck.Processes, ProcessModel{
+				LinuxPath:   types.StringPointerValue(process.LinuxPath),
+				MacPath:     types.StringPointerValue(process.MacPath),
+				WindowsPath: types.StringPointerValue(process.WindowsPath),
+			})
+		}
+	}
+
+	data.GeoLocationCheck = nil
+	if checks.GeoLocationCheck != nil {
+		data.GeoLocationCheck = &GeoLocationCheckModel{
+			Action:    types.StringValue(string(checks.GeoLocationCheck.Action)),
+			Locations: []LocationModel{},
+		}
+		for _, location := range checks.GeoLocationCheck.Locations {
+			cityName := types.StringPointerValue(location.CityName)
+			// The API may return the city of a country-wide location as empty
+			if location.CityName != nil && *location.CityName == "" {
+				cityName = types.StringNull()
+			}
+			data.GeoLocationCheck.Locations = append(data.GeoLocationCheck.Locations, LocationModel{
+				CountryCode: types.StringValue(location.CountryCode),
+				CityName:    cityName,
+			})
+		}
+	}
+
+	return diags
+}
+
+func (r *PostureCheckResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data PostureCheckResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	request, diags := postureCheckModelToApi(data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Retry failed requests unless they created the posture check, which
+	// would otherwise be created twice
+	retryCtx := withPostRetry(ctx, lookupCreatedByName(r.client, "/api/posture-checks", data.Name.ValueString()))
+	postureCheck, err := postJSON[netbirdApi.PostureCheck](retryCtx, r.client, "/api/posture-checks", request)
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating posture check", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(postureCheckToModel(*postureCheck, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIDIdentity(ctx, resp.Identity, data.ID)...)
+}
+
+func (r *PostureCheckResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data PostureCheckResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The framework requires an identity even when the object has been deleted
+	resp.Diagnostics.Append(setIDIdentity(ctx, resp.Identity, data.ID)...)
+
+	postureCheck, err := getJSON[netbirdApi.PostureCheck](ctx, r.client, fmt.Sprintf("/api/posture-checks/%s", data.ID.ValueString()))
+	if err != nil {
+		resp.Diagnostics.AddError("Error fetching posture check", err.Error())
+		return
+	}
+
+	// The object has been deleted outside of Terraform
+	if postureCheck == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(postureCheckToModel(*postureCheck, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PostureCheckResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data PostureCheckResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	request, diags := postureCheckModelToApi(data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The update replaces all checks, so every configured check is sent
+	endpoint := fmt.Sprintf("/api/posture-checks/%s", data.ID.ValueString())
+	postureCheck, err := putJSON[netbirdApi.PostureCheck](ctx, r.client, endpoint, request)
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating posture check", err.Error())
+		return
+	}
+	if postureCheck == nil {
+		postureCheck, err = requireJSON(getJSON[netbirdApi.PostureCheck](ctx, r.client, endpoint))
+		if err != nil {
+			resp.Diagnostics.AddError("Error fetching posture check", err.Error())
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(postureCheckToModel(*postureCheck, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIDIdentity(ctx, resp.Identity, data.ID)...)
+}
+
+func (r *PostureCheckResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data PostureCheckResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := deleteObject(ctx, r.client, fmt.Sprintf("/api/posture-checks/%s", data.ID.ValueString()))
+	if err != nil {
+		resp.Diagnostics.AddError("Error deleting posture check", err.Error())
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *PostureCheckResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
+}
@@ -0,0 +1,389 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+func TestAccPostureCheckResource(t *testing.T) {
+	providerConfig, mock := testAccProviderConfig(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckDestroy(mock, "netbird_posture_check", staticPath("/api/posture-checks")),
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + testAccPostureCheckResourceConfig(`
+  nb_version_check = {
+    min_version = "0.35.0"
+  }
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("netbird_posture_check.test", "id"),
+					resource.TestCheckResourceAttr("netbird_posture_check.test", "name", "tf-acc-posture-check"),
+					resource.TestCheckResourceAttr("netbird_posture_check.test", "description", ""),
+					resource.TestCheckResourceAttr("netbird_posture_check.test", "nb_version_check.min_version", "0.35.0"),
+					resource.TestCheckNoResourceAttr("netbird_posture_check.test", "os_version_check"),
+					resource.TestCheckNoResourceAttr("netbird_posture_check.test", "peer_network_range_check"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "netbird_posture_check.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Checks of different types are combined in one posture check
+			{
+				Config: providerConfig + testAccPostureCheckResourceConfig(`
+  nb_version_check = {
+    min_version = "0.35.0"
+  }
+  os_version_check = {
+    darwin = {
+      min_version = "14.0"
+    }
+    linux = {
+      min_kernel_version = "5.15.0"
+    }
+  }
+  peer_network_range_check = {
+    action = "deny"
+    ranges = ["192.168.0.0/16", "10.0.0.0/8"]
+  }
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("netbird_posture_check.test", "nb_version_check.min_version", "0.35.0"),
+					resource.TestCheckResourceAttr("netbird_posture_check.test", "os_version_check.darwin.min_version", "14.0"),
+					resource.TestCheckResourceAttr("netbird_posture_check.test", "os_version_check.linux.min_kernel_version", "5.15.0"),
+					resource.TestCheckNoResourceAttr("netbird_posture_check.test", "os_version_check.windows"),
+					resource.TestCheckResourceAttr("netbird_posture_check.test", "peer_network_range_check.action", "deny"),
+					resource.TestCheckResourceAttr("netbird_posture_check.test", "peer_network_range_check.ranges.#", "2"),
+					resource.TestCheckResourceAttr("netbird_posture_check.test", "peer_network_range_check.ranges.1", "10.0.0.0/8"),
+				),
+			},
+			// Changing one check keeps the others
+			{
+				Config: providerConfig + testAccPostureCheckResourceConfig(`
+  nb_version_check = {
+    min_version = "0.36.0"
+  }
+  os_version_check = {
+    darwin = {
+      min_version = "14.0"
+    }
+    linux = {
+      min_kernel_version = "5.15.0"
+    }
+  }
+  peer_network_range_check = {
+    action = "deny"
+    ranges = ["192.168.0.0/16", "10.0.0.0/8"]
+  }
+`),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("netbird_posture_check.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("netbird_posture_check.test", "nb_version_check.min_version", "0.36.0"),
+					resource.TestCheckResourceAttr("netbird_posture_check.test", "os_version_check.darwin.min_version", "14.0"),
+					resource.TestCheckResourceAttr("netbird_posture_check.test", "peer_network_range_check.action", "deny"),
+				),
+			},
+			// Removing a check from the configuration removes it from the posture check
+			{
+				Config: providerConfig + testAccPostureCheckResourceConfig(`
+  peer_network_range_check = {
+    action = "allow"
+    ranges = ["10.0.0.0/8"]
+  }
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("netbird_posture_check.test", "nb_version_check"),
+					resource.TestCheckNoResourceAttr("netbird_posture_check.test", "os_version_check"),
+					resource.TestCheckResourceAttr("netbird_posture_check.test", "peer_network_range_check.action", "allow"),
+					resource.TestCheckResourceAttr("netbird_posture_check.test", "peer_network_range_check.ranges.#", "1"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccPostureCheckResource_validation(t *testing.T) {
+	providerConfig, _ := testAccProviderConfig(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      providerConfig + testAccPostureCheckResourceConfig(""),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("Missing posture check"),
+			},
+			{
+				Config: providerConfig + testAccPostureCheckResourceConfig(`
+  peer_network_range_check = {
+    action = "block"
+    ranges = ["10.0.0.0/8"]
+  }
+`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("must be either allow or deny"),
+			},
+			{
+				Config: providerConfig + testAccPostureCheckResourceConfig(`
+  peer_network_range_check = {
+    action = "allow"
+    ranges = ["10.0.0.1"]
+  }
+`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("Invalid network range"),
+			},
+			{
+				Config: providerConfig + testAccPostureCheckResourceConfig(`
+  nb_version_check = {
+    min_version = "latest"
+  }
+`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("Invalid version"),
+			},
+			{
+				Config: providerConfig + testAccPostureCheckResourceConfig(`
+  process_check = {
+    processes = []
+  }
+`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("Missing process"),
+			},
+			{
+				Config: providerConfig + testAccPostureCheckResourceConfig(`
+  process_check = {
+    processes = [{}]
+  }
+`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("Missing process path"),
+			},
+			{
+				Config: providerConfig + testAccPostureCheckResourceConfig(`
+  process_check = {
+    processes = [{
+      linux_path = ""
+    }]
+  }
+`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("must be a non-empty path"),
+			},
+			{
+				Config: providerConfig + testAccPostureCheckResourceConfig(`
+  geo_location_check = {
+    action    = "block"
+    locations = [{ country_code = "DE" }]
+  }
+`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("must be either allow or deny"),
+			},
+			{
+				Config: providerConfig + testAccPostureCheckResourceConfig(`
+  geo_location_check = {
+    action    = "allow"
+    locations = [{ country_code = "Germany" }]
+  }
+`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("ISO 3166-1 alpha-2 country code"),
+			},
+			{
+				Config: providerConfig + testAccPostureCheckResourceConfig(`
+  geo_location_check = {
+    action    = "allow"
+    locations = [{ country_code = "DE", city_name = "" }]
+  }
+`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("must be a non-empty city name"),
+			},
+		},
+	})
+}
+
+func TestAccPostureCheckResource_processCheck(t *testing.T) {
+	providerConfig, mock := testAccProviderConfig(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckDestroy(mock, "netbird_posture_check", staticPath("/api/posture-checks")),
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + testAccPostureCheckResourceConfig(`
+  process_check = {
+    processes = [
+      {
+        linux_path   = "/usr/bin/falcon-sensor"
+        mac_path     = "/Applications/Falcon.app/Contents/Resources/falcond"
+        windows_path = "C:\\Program Files\\CrowdStrike\\CSFalconService.exe"
+      },
+      {
+        linux_path = "/usr/sbin/sshd"
+      },
+    ]
+  }
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("netbird_posture_check.test", "process_check.processes.#", "2"),
+					resource.TestCheckResourceAttr("netbird_posture_check.test", "process_check.processes.0.linux_path", "/usr/bin/falcon-sensor"),
+					resource.TestCheckResourceAttr("netbird_posture_check.test", "process_check.processes.0.mac_path", "/Applications/Falcon.app/Contents/Resources/falcond"),
+					resource.TestCheckResourceAttr("netbird_posture_check.test", "process_check.processes.0.windows_path", `C:\Program Files\CrowdStrike\CSFalconService.exe`),
+					resource.TestCheckResourceAttr("netbird_posture_check.test", "process_check.processes.1.linux_path", "/usr/sbin/sshd"),
+					resource.TestCheckNoResourceAttr("netbird_posture_check.test", "process_check.processes.1.mac_path"),
+					resource.TestCheckNoResourceAttr("netbird_posture_check.test", "process_check.processes.1.windows_path"),
+				),
+			},
+			{
+				ResourceName:      "netbird_posture_check.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Process checks are combined with other checks
+			{
+				Config: providerConfig + testAccPostureCheckResourceConfig(`
+  nb_version_check = {
+    min_version = "0.35.0"
+  }
+  process_check = {
+    processes = [{
+      linux_path = "/usr/sbin/sshd"
+    }]
+  }
+`),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("netbird_posture_check.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("netbird_posture_check.test", "nb_version_check.min_version", "0.35.0"),
+					resource.TestCheckResourceAttr("netbird_posture_check.test", "process_check.processes.#", "1"),
+					resource.TestCheckResourceAttr("netbird_posture_check.test", "process_check.processes.0.linux_path", "/usr/sbin/sshd"),
+				),
+			},
+			{
+				Config: providerConfig + testAccPostureCheckResourceConfig(`
+  nb_version_check = {
+    min_version = "0.35.0"
+  }
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("netbird_posture_check.test", "process_check"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccPostureCheckResource_geoLocationCheck(t *testing.T) {
+	providerConfig, mock := testAccProviderConfig(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckDestroy(mock, "netbird_posture_check", staticPath("/api/posture-checks")),
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + testAccPostureCheckResourceConfig(`
+  geo_location_check = {
+    action = "allow"
+    locations = [
+      { country_code = "DE" },
+      { country_code = "FR", city_name = "Paris" },
+    ]
+  }
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("netbird_posture_check.test", "geo_location_check.action", "allow"),
+					resource.TestCheckResourceAttr("netbird_posture_check.test", "geo_location_check.locations.#", "2"),
+					resource.TestCheckResourceAttr("netbird_posture_check.test", "geo_location_check.locations.0.country_code", "DE"),
+					resource.TestCheckNoResourceAttr("netbird_posture_check.test", "geo_location_check.locations.0.city_name"),
+					resource.TestCheckResourceAttr("netbird_posture_check.test", "geo_location_check.locations.1.country_code", "FR"),
+					resource.TestCheckResourceAttr("netbird_posture_check.test", "geo_location_check.locations.1.city_name", "Paris"),
+				),
+			},
+			{
+				ResourceName:      "netbird_posture_check.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: providerConfig + testAccPostureCheckResourceConfig(`
+  geo_location_check = {
+    action    = "deny"
+    locations = [{ country_code = "FR", city_name = "Paris" }]
+  }
+`),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("netbird_posture_check.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("netbird_posture_check.test", "geo_location_check.action", "deny"),
+					resource.TestCheckResourceAttr("netbird_posture_check.test", "geo_location_check.locations.#", "1"),
+					resource.TestCheckResourceAttr("netbird_posture_check.test", "geo_location_check.locations.0.city_name", "Paris"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccPostureCheckResource_disappears(t *testing.T) {
+	testAccMockOnly(t)
+	providerConfig, mock := testAccProviderConfig(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + testAccPostureCheckResourceConfig(`
+  nb_version_check = {
+    min_version = "0.35.0"
+  }
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDisappears(mock, "netbird_posture_check.test", staticPath("/api/posture-checks")),
+				),
+				// The deleted object is removed from state and planned for creation
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("netbird_posture_check.test", plancheck.ResourceActionCreate),
+					},
+				},
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccPostureCheckResourceConfig(checks string) string {
+	return fmt.Sprintf(`
+resource "netbird_posture_check" "test" {
+  name = "tf-acc-posture-check"
+%s}
+`, checks)
+}
@@ -4,31 +4,548 @@
 package provider
 
 import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"regexp"
+	"runtime"
+	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-testing/echoprovider"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/matthewjohn/terraform-provider-netbird/internal/testutils"
 )
 
 // testAccProtoV6ProviderFactories is used to instantiate a provider during acceptance testing.
 // The factory function is called for each Terraform CLI command to create a provider
 // server that the CLI can connect to and interact with.
 var testAccProtoV6ProviderFactories = map[string]func() (tfprotov6.ProviderServer, error){
-	"scaffolding": providerserver.NewProtocol6WithError(New("test")()),
+	"netbird": providerserver.NewProtocol6WithError(New("test")()),
 }
 
-// testAccProtoV6ProviderFactoriesWithEcho includes the echo provider alongside the scaffolding provider.
+// testAccProtoV6ProviderFactoriesWithEcho includes the echo provider alongside the netbird provider.
 // It allows for testing assertions on data returned by an ephemeral resource during Open.
 // The echoprovider is used to arrange tests by echoing ephemeral data into the Terraform state.
 // This lets the data be referenced in test assertions with state checks.
 var testAccProtoV6ProviderFactoriesWithEcho = map[string]func() (tfprotov6.ProviderServer, error){
-	"scaffolding": providerserver.NewProtocol6WithError(New("test")()),
-	"echo":        echoprovider.NewProviderServer(),
+	"netbird": providerserver.NewProtocol6WithError(New("test")()),
+	"echo":    echoprovider.NewProviderServer(),
+}
+
+// testAccLive reports whether acceptance tests should run against the live
+// NetBird instance configured through the NETBIRD_* environment variables, or
+// the dedicated test account whose token is NETBIRD_TEST_ACCOUNT_TOKEN.
+func testAccLive() bool {
+	return os.Getenv("NETBIRD_ACCEPTANCE_TESTS") == "true" || os.Getenv("NETBIRD_TEST_ACCOUNT_TOKEN") != ""
 }
 
 func testAccPreCheck(t *testing.T) {
-	// You can add code here to run prior to any test case execution, for example assertions
-	// about the appropriate environment variables being set are common to see in a pre-check
-	// function.
+	if !testAccLive() {
+		return
+	}
+	if os.Getenv("NETBIRD_TEST_ACCOUNT_TOKEN") == "" && os.Getenv("NETBIRD_ACCESS_TOKEN") == "" && os.Getenv("NETBIRD_BEARER_TOKEN") == "" {
+		t.Fatal("NETBIRD_TEST_ACCOUNT_TOKEN, NETBIRD_ACCESS_TOKEN or NETBIRD_BEARER_TOKEN must be set for live acceptance tests")
+	}
+}
+
+// testAccLiveClient returns a client for the live NetBird instance, used to
+// verify objects were removed from the API.
+func testAccLiveClient() (*Client, error) {
+	endpoint, _ := resolveEndpoint("", os.Getenv)
+	endpoint, err := normalizeEndpoint(endpoint)
+	if err != nil {
+		return nil, err
+	}
+	accessToken := os.Getenv("NETBIRD_TEST_ACCOUNT_TOKEN")
+	if accessToken == "" {
+		accessToken = os.Getenv("NETBIRD_ACCESS_TOKEN")
+	}
+	bearerToken := ""
+	if accessToken == "" {
+		bearerToken = os.Getenv("NETBIRD_BEARER_TOKEN")
+	}
+	return NewClient(endpoint, bearerToken, accessToken, "test"), nil
+}
+
+// testAccMockOnly skips tests that depend on data seeded into the mock server.
+func testAccMockOnly(t *testing.T) {
+	if testAccLive() {
+		t.Skip("test requires the mock NetBird API")
+	}
+}
+
+// testAccProviderConfig returns the provider block used by acceptance tests.
+// Unless live tests are enabled, a mock NetBird API is started for the test
+// and the provider is pointed at it; the mock is returned so tests can seed
+// data and inspect what the provider created. For live tests the mock is nil
+// and the provider is configured from the environment.
+func testAccProviderConfig(t *testing.T) (string, *testutils.MockServer) {
+	if token := os.Getenv("NETBIRD_TEST_ACCOUNT_TOKEN"); token != "" {
+		return fmt.Sprintf(`
+provider "netbird" {
+  access_token = %q
+}
+`, token), nil
+	}
+	if testAccLive() {
+		return "", nil
+	}
+	mock := testutils.NewMockServer(t)
+	return fmt.Sprintf(`
+provider "netbird" {
+  endpoint     = %q
+  access_token = %q
+}
+`, mock.URL, testutils.MockToken), mock
+}
+
+// testAccCheckDestroy verifies that every resource of resourceType has been
+// removed from the mock API, or the live API when mock is nil. collectionPath
+// returns the API collection a resource is stored in.
+func testAccCheckDestroy(mock *testutils.MockServer, resourceType string, collectionPath func(*terraform.ResourceState) string) func(*terraform.State) error {
+	return func(s *terraform.State) error {
+		exists := func(path string, id string) (bool, error) {
+			return mock.Exists(path, id), nil
+		}
+		if mock == nil {
+			client, err := testAccLiveClient()
+			if err != nil {
+				return err
+			}
+			exists = func(path string, id string) (bool, error) {
+				body, err := client.DoGet(context.Background(), path+"/"+id)
+				return body != nil, err
+			}
+		}
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != resourceType {
+				continue
+			}
+			found, err := exists(collectionPath(rs), rs.Primary.ID)
+			if err != nil {
+				return err
+			}
+			if found {
+				return fmt.Errorf("%s %s still exists", resourceType, rs.Primary.ID)
+			}
+		}
+		return nil
+	}
+}
+
+// testAccCheckDisappears deletes the object of resourceName from the mock API,
+// so the following plan shows whether the provider notices it is gone.
+func testAccCheckDisappears(mock *testutils.MockServer, resourceName string, collectionPath func(*terraform.ResourceState) string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource %s not found in state", resourceName)
+		}
+		mock.Delete(collectionPath(rs), rs.Primary.ID)
+		return nil
+	}
+}
+
+// staticPath is a collectionPath for resources stored in a fixed collection.
+func staticPath(path string) func(*terraform.ResourceState) string {
+	return func(*terraform.ResourceState) string {
+		return path
+	}
+}
+
+func TestParseRequestTimeout(t *testing.T) {
+	for value, want := range map[string]time.Duration{
+		"90s":   90 * time.Second,
+		"2m":    2 * time.Minute,
+		"1m30s": 90 * time.Second,
+		"45":    45 * time.Second,
+	} {
+		got, err := parseRequestTimeout(value)
+		if err != nil {
+			t.Errorf("%q: unexpected error: %s", value, err)
+		} else if got != want {
+			t.Errorf("%q: expected %s, got %s", value, want, got)
+		}
+	}
+
+	for _, value := range []string{"0", "0s", "-5", "-1m", "soon"} {
+		if _, err := parseRequestTimeout(value); err == nil {
+			t.Errorf("%q: expected an error", value)
+		}
+	}
+}
+
+func TestAccProvider_conflictingOAuthCredentials(t *testing.T) {
+	testAccMockOnly(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+provider "netbird" {
+  endpoint            = "http://127.0.0.1:1"
+  access_token        = "nbp_token"
+  oauth_client_id     = "client"
+  oauth_client_secret = "secret"
+  oauth_token_url     = "http://127.0.0.1:1/oauth/token"
+}
+
+data "netbird_peers" "all" {}
+`,
+				ExpectError: regexp.MustCompile("Conflicting arguments: OAuth2 client credentials and token"),
+			},
+		},
+	})
+}
+
+func TestAccProvider_incompleteOAuthCredentials(t *testing.T) {
+	testAccMockOnly(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+provider "netbird" {
+  endpoint        = "http://127.0.0.1:1"
+  oauth_client_id = "client"
+}
+
+data "netbird_peers" "all" {}
+`,
+				ExpectError: regexp.MustCompile("Incomplete OAuth2 client credentials"),
+			},
+		},
+	})
+}
+
+func TestReadTokenFile(t *testing.T) {
+	dir := t.TempDir()
+	tokenFile := filepath.Join(dir, "token")
+	if err := os.WriteFile(tokenFile, []byte("nbp_token\r\n\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	token, err := readTokenFile(tokenFile)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if token != "nbp_token" {
+		t.Errorf("expected trailing newlines to be trimmed, got %q", token)
+	}
+
+	emptyFile := filepath.Join(dir, "empty")
+	if err := os.WriteFile(emptyFile, []byte("\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := readTokenFile(emptyFile); err == nil {
+		t.Error("expected error for empty token file")
+	}
+	if _, err := readTokenFile(filepath.Join(dir, "missing")); err == nil {
+		t.Error("expected error for missing token file")
+	}
+}
+
+func TestAccProvider_accessTokenFile(t *testing.T) {
+	testAccMockOnly(t)
+	mock := testutils.NewMockServer(t)
+	tokenFile := filepath.Join(t.TempDir(), "token")
+	if err := os.WriteFile(tokenFile, []byte(testutils.MockToken+"\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+provider "netbird" {
+  endpoint          = %q
+  access_token_file = %q
+}
+
+data "netbird_peers" "all" {}
+`, mock.URL, tokenFile),
+				Check: resource.TestCheckResourceAttr("data.netbird_peers.all", "peers.#", "0"),
+			},
+		},
+	})
+}
+
+func TestAccProvider_conflictingAccessTokenFile(t *testing.T) {
+	testAccMockOnly(t)
+	tokenFile := filepath.Join(t.TempDir(), "token")
+	if err := os.WriteFile(tokenFile, []byte("nbp_token\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+provider "netbird" {
+  endpoint          = "http://127.0.0.1:1"
+  access_token      = "nbp_token"
+  access_token_file = %q
+}
+
+data "netbird_peers" "all" {}
+`, tokenFile),
+				ExpectError: regexp.MustCompile("Access token and access token file"),
+			},
+		},
+	})
+}
+
+func TestAccProvider_userAgent(t *testing.T) {
+	testAccMockOnly(t)
+	var mu sync.Mutex
+	var userAgent string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		userAgent = r.UserAgent()
+		mu.Unlock()
+		_, _ = w.Write([]byte(`[]`))
+	}))
+	defer server.Close()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+provider "netbird" {
+  endpoint     = %q
+  access_token = "nbp_token"
+  user_agent   = "ci-pipeline/1.0"
+}
+
+data "netbird_peers" "all" {}
+`, server.URL),
+				Check: func(*terraform.State) error {
+					mu.Lock()
+					defer mu.Unlock()
+					want := "terraform-provider-netbird/test (" + runtime.GOOS + "; terraform-plugin-framework) ci-pipeline/1.0"
+					if userAgent != want {
+						return fmt.Errorf("expected User-Agent %q, got %q", want, userAgent)
+					}
+					return nil
+				},
+			},
+		},
+	})
+}
+
+func TestParseDuration(t *testing.T) {
+	for value, want := range map[string]time.Duration{
+		"0":   0,
+		"0s":  0,
+		"10s": 10 * time.Second,
+		"5":   5 * time.Second,
+	} {
+		got, err := parseDuration(value)
+		if err != nil {
+			t.Errorf("%q: unexpected error: %s", value, err)
+		} else if got != want {
+			t.Errorf("%q: expected %s, got %s", value, want, got)
+		}
+	}
+
+	for _, value := range []string{"-5", "-1m", "soon"} {
+		if _, err := parseDuration(value); err == nil {
+			t.Errorf("%q: expected an error", value)
+		}
+	}
+}
+
+func TestAccProvider_invalidRetryConfiguration(t *testing.T) {
+	testAccMockOnly(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+provider "netbird" {
+  endpoint           = "http://127.0.0.1:1"
+  access_token       = "nbp_token"
+  retry_max_attempts = -1
+}
+
+data "netbird_peers" "all" {}
+`,
+				ExpectError: regexp.MustCompile("Invalid maximum retry attempts"),
+			},
+			{
+				Config: `
+provider "netbird" {
+  endpoint          = "http://127.0.0.1:1"
+  access_token      = "nbp_token"
+  retry_max_backoff = "-10s"
+}
+
+data "netbird_peers" "all" {}
+`,
+				ExpectError: regexp.MustCompile("Invalid maximum retry backoff"),
+			},
+			{
+				Config: `
+provider "netbird" {
+  endpoint               = "http://127.0.0.1:1"
+  access_token           = "nbp_token"
+  slow_request_threshold = "soon"
+}
+
+data "netbird_peers" "all" {}
+`,
+				ExpectError: regexp.MustCompile("Invalid slow request threshold"),
+			},
+		},
+	})
+}
+
+func TestAccProvider_incompleteClientCertificate(t *testing.T) {
+	testAccMockOnly(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+provider "netbird" {
+  endpoint           = "https://127.0.0.1:1"
+  access_token       = "nbp_token"
+  client_certificate = "/etc/netbird/client.pem"
+}
+
+data "netbird_peers" "all" {}
+`,
+				ExpectError: regexp.MustCompile("Incomplete client certificate"),
+			},
+		},
+	})
+}
+
+func TestAccProvider_disableResponseCache(t *testing.T) {
+	testAccMockOnly(t)
+	for disabled, want := range map[bool]int32{false: 1, true: 2} {
+		t.Run(fmt.Sprintf("disable_response_cache=%t", disabled), func(t *testing.T) {
+			var lists atomic.Int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				lists.Add(1)
+				_, _ = w.Write([]byte(`[]`))
+			}))
+			defer server.Close()
+
+			resource.Test(t, resource.TestCase{
+				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config: fmt.Sprintf(`
+provider "netbird" {
+  endpoint               = %q
+  access_token           = "nbp_token"
+  disable_response_cache = %t
+}
+
+data "netbird_peers" "first" {}
+
+data "netbird_peers" "second" {}
+`, server.URL, disabled),
+						// Both data sources are read while planning the first apply
+						Check: func(*terraform.State) error {
+							if got := lists.Load(); got != want {
+								return fmt.Errorf("expected %d requests listing peers, got %d", want, got)
+							}
+							return nil
+						},
+					},
+				},
+			})
+		})
+	}
+}
+
+// testConfigureProvider runs the provider Configure with the given attributes,
+// leaving the others unset.
+func testConfigureProvider(t *testing.T, attributes map[string]tftypes.Value) *provider.ConfigureResponse {
+	t.Helper()
+	ctx := context.Background()
+	p := New("test")()
+
+	schemaResp := &provider.SchemaResponse{}
+	p.Schema(ctx, provider.SchemaRequest{}, schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	values := map[string]tftypes.Value{}
+	for name, attributeType := range objectType.AttributeTypes {
+		values[name] = tftypes.NewValue(attributeType, nil)
+		if value, ok := attributes[name]; ok {
+			values[name] = value
+		}
+	}
+
+	resp := &provider.ConfigureResponse{}
+	p.Configure(ctx, provider.ConfigureRequest{
+		Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, values)},
+	}, resp)
+	return resp
+}
+
+func TestProviderConfigureEndpointPrecedence(t *testing.T) {
+	for _, tc := range []struct {
+		name          string
+		configured    string
+		endpoint      string
+		managementURL string
+		expected      string
+		warning       bool
+	}{
+		{name: "default", expected: "https://api.netbird.io"},
+		{name: "management url", managementURL: "https://management.example.com:443", expected: "https://management.example.com:443"},
+		{name: "endpoint", endpoint: "https://endpoint.example.com", expected: "https://endpoint.example.com"},
+		{name: "endpoint with trailing slash", endpoint: "https://api.netbird.io/", expected: "https://api.netbird.io"},
+		{name: "both equal", endpoint: "https://netbird.example.com/", managementURL: "https://netbird.example.com", expected: "https://netbird.example.com"},
+		{name: "both conflicting", endpoint: "https://endpoint.example.com", managementURL: "https://management.example.com", expected: "https://endpoint.example.com", warning: true},
+		{name: "configured", configured: "https://configured.example.com", endpoint: "https://endpoint.example.com", expected: "https://configured.example.com"},
+		{name: "configured with trailing slash", configured: "https://configured.example.com/netbird/", expected: "https://configured.example.com/netbird"},
+		{name: "configured with conflicting environment", configured: "https://configured.example.com", endpoint: "https://endpoint.example.com", managementURL: "https://management.example.com", expected: "https://configured.example.com"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			for _, name := range []string{"NETBIRD_BEARER_TOKEN", "NETBIRD_OAUTH_CLIENT_ID", "NETBIRD_OAUTH_CLIENT_SECRET", "NETBIRD_OAUTH_TOKEN_URL"} {
+				t.Setenv(name, "")
+			}
+			t.Setenv("NETBIRD_ENDPOINT", tc.endpoint)
+			t.Setenv("NETBIRD_MANAGEMENT_URL", tc.managementURL)
+
+			attributes := map[string]tftypes.Value{"access_token": tftypes.NewValue(tftypes.String, "nbp_token")}
+			if tc.configured != "" {
+				attributes["endpoint"] = tftypes.NewValue(tftypes.String, tc.configured)
+			}
+			resp := testConfigureProvider(t, attributes)
+
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected errors: %v", resp.Diagnostics)
+			}
+			if got := resp.ResourceData.(*Client).BaseUrl; got != tc.expected {
+				t.Errorf("expected endpoint %q, got %q", tc.expected, got)
+			}
+			if got := resp.Diagnostics.WarningsCount() > 0; got != tc.warning {
+				t.Errorf("expected warning=%t, got diagnostics %v", tc.warning, resp.Diagnostics)
+			}
+		})
+	}
 }
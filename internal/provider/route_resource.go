@@ -0,0 +1,476 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	netbirdApi "github.com/netbirdio/netbird/management/server/http/api"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &RouteResource{}
+var _ resource.ResourceWithImportState = &RouteResource{}
+var _ resource.ResourceWithConfigValidators = &RouteResource{}
+
+func NewRouteResource() resource.Resource {
+	return &RouteResource{}
+}
+
+// RouteResource defines the resource implementation.
+type RouteResource struct {
+	client ClientInterface
+}
+
+type RouteResourceModel struct {
+	ID                  types.String `tfsdk:"id"`
+	NetworkID           types.String `tfsdk:"network_id"`
+	Description         types.String `tfsdk:"description"`
+	Network             types.String `tfsdk:"network"`
+	Domains             types.List   `tfsdk:"domains"`
+	KeepRoute           types.Bool   `tfsdk:"keep_route"`
+	NetworkType         types.String `tfsdk:"network_type"`
+	Peer                types.String `tfsdk:"peer"`
+	PeerGroups          types.List   `tfsdk:"peer_groups"`
+	Groups              types.List   `tfsdk:"groups"`
+	AccessControlGroups types.Set    `tfsdk:"access_control_groups"`
+	Metric              types.Int32  `tfsdk:"metric"`
+	Masquerade          types.Bool   `tfsdk:"masquerade"`
+	Enabled             types.Bool   `tfsdk:"enabled"`
+}
+
+func (r *RouteResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_route"
+}
+
+func (r *RouteResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Route resource, routing either a network range or a list of domains through routing peers. " +
+			"Set `network` for a network route, or `domains` for a domain route whose addresses are resolved dynamically.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Route ID",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"network_id": schema.StringAttribute{
+				MarkdownDescription: "Route network identifier, routes with the same identifier form a highly available route",
+				Required:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Description of the route",
+				Optional:            true,
+			},
+			"network": schema.StringAttribute{
+				MarkdownDescription: "Network range in CIDR format, e.g. `10.0.0.0/16`. This property can not be set together with domains",
+				Optional:            true,
+			},
+			"domains": schema.ListAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "Domains to resolve dynamically and route, e.g. `example.com` or `*.example.com`. This property can not be set together with network",
+				Optional:            true,
+				Validators: []validator.List{
+					domainListValidator{},
+				},
+			},
+			"keep_route": schema.BoolAttribute{
+				MarkdownDescription: "Keep routes to addresses a domain no longer resolves to. Only applies to domain routes",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"network_type": schema.StringAttribute{
+				MarkdownDescription: "Type of the route, `Domain` for domain routes or `IPv4`/`IPv6` for network routes",
+				Computed:            true,
+			},
+			"peer": schema.StringAttribute{
+				MarkdownDescription: "Peer ID associated with route. This property can not be set together with peer_groups",
+				Optional:            true,
+			},
+			"peer_groups": schema.ListAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "Peers Group IDs associated with route. This property can not be set together with peer",
+				Optional:            true,
+			},
+			"groups": schema.ListAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "Group IDs of the peers the route is distributed to",
+				Required:            true,
+			},
+			"access_control_groups": schema.SetAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "Group IDs used as the destination of policies controlling access to the route",
+				Optional:            true,
+			},
+			"metric": schema.Int32Attribute{
+				MarkdownDescription: "Route metric number. Lowest number has higher priority",
+				Optional:            true,
+				Computed:            true,
+				Default:             int32default.StaticInt32(9999),
+			},
+			"masquerade": schema.BoolAttribute{
+				MarkdownDescription: "Indicate if peer should masquerade traffic to this route's prefix",
+				Required:            true,
+			},
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Route status",
+				Required:            true,
+			},
+		},
+	}
+}
+
+func (r *RouteResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		routeDestinationValidator{},
+		routePeerValidator{},
+	}
+}
+
+// routeDestinationValidator checks a route has either a network or domains, and
+// that keep_route is only set for domain routes.
+type routeDestinationValidator struct{}
+
+func (v routeDestinationValidator) Description(ctx context.Context) string {
+	return "exactly one of network and domains must be set, keep_route only applies when domains are set"
+}
+
+func (v routeDestinationValidator) MarkdownDescription(ctx context.Context) string {
+	return "exactly one of `network` and `domains` must be set, `keep_route` only applies when `domains` are set"
+}
+
+func (v routeDestinationValidator) ValidateResource(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var network types.String
+	var domains types.List
+	var keepRoute types.Bool
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("network"), &network)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("domains"), &domains)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("keep_route"), &keepRoute)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Values may not be known until apply
+	if network.IsUnknown() || domains.IsUnknown() {
+		return
+	}
+
+	hasNetwork := network.ValueString() != ""
+	hasDomains := len(domains.Elements()) > 0
+	switch {
+	case hasNetwork && hasDomains:
+		resp.Diagnostics.AddAttributeError(
+			path.Root("domains"),
+			"Conflicting route destination",
+			"A route is either a network route or a domain route. Remove the network or the domains.",
+		)
+	case !hasNetwork && !hasDomains:
+		resp.Diagnostics.AddAttributeError(
+			path.Root("network"),
+			"Missing route destination",
+			"A route must have a network, or at least one domain for a domain route.",
+		)
+	case hasNetwork:
+		if _, err := netip.ParsePrefix(network.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("network"),
+				"Invalid network",
+				fmt.Sprintf("%q is not a network range in CIDR format such as 10.0.0.0/16.", network.ValueString()),
+			)
+		}
+		if keepRoute.ValueBool() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("keep_route"),
+				"Unexpected keep_route",
+				"keep_route only applies to domain routes. Remove it or route domains instead of a network.",
+			)
+		}
+	}
+}
+
+// routePeerValidator checks a route has either a routing peer or peer groups.
+type routePeerValidator struct{}
+
+func (v routePeerValidator) Description(ctx context.Context) string {
+	return "exactly one of peer or peer_groups must be specified"
+}
+
+func (v routePeerValidator) MarkdownDescription(ctx context.Context) string {
+	return "exactly one of `peer` or `peer_groups` must be specified"
+}
+
+func (v routePeerValidator) ValidateResource(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var peer types.String
+	var peerGroups types.List
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("peer"), &peer)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("peer_groups"), &peerGroups)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Values may not be known until apply
+	if peer.IsUnknown() || peerGroups.IsUnknown() {
+		return
+	}
+
+	hasPeer := peer.ValueString() != ""
+	hasPeerGroups := len(peerGroups.Elements()) > 0
+	if hasPeer == hasPeerGroups {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("peer_groups"),
+			"Invalid routing peers",
+			"Exactly one of peer or peer_groups must be specified: peer routes through a single peer, "+
+				"peer_groups through the peers of groups for a highly available route.",
+		)
+	}
+}
+
+func (r *RouteResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(ClientInterface)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *RouteResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data RouteResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiData, diags := routeModelToApiRequest(data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Make API request
+	responseData, err := postJSON[netbirdApi.Route](ctx, r.client, "/api/routes", apiData)
+	if err != nil {
+		resp.Diagnostics.AddError("Error making API request", err.Error())
+		return
+	}
+
+	// Assign values from API response
+	data.ID = types.StringValue(responseData.Id)
+
+	diags = r.readRouteIntoModel(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *RouteResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data RouteResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags := r.readRouteIntoModel(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The object has been deleted outside of Terraform
+	if data.ID.IsNull() {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *RouteResource) readRouteIntoModel(ctx context.Context, data *RouteResourceModel) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+	responseData, err := getJSON[netbirdApi.Route](ctx, r.client, fmt.Sprintf("/api/routes/%s", data.ID.ValueString()))
+	if err != nil {
+		diags.AddError("Error fetching route", err.Error())
+		return diags
+	}
+	// If not found
+	if responseData == nil {
+		data.ID = types.StringNull()
+		return diags
+	}
+
+	// Update state with latest data
+	data.NetworkID = types.StringValue(responseData.NetworkId)
+	data.Description = nullStringToEmptyString(types.StringValue(responseData.Description))
+	data.NetworkType = types.StringValue(responseData.NetworkType)
+	data.KeepRoute = types.BoolValue(responseData.KeepRoute)
+	data.Metric = types.Int32Value(int32(responseData.Metric))
+	data.Masquerade = types.BoolValue(responseData.Masquerade)
+	data.Enabled = types.BoolValue(responseData.Enabled)
+	data.Peer = nullStringToEmptyString(derefString(responseData.Peer))
+
+	domains := derefStringSlice(responseData.Domains)
+	data.Network = types.StringNull()
+	if len(domains) == 0 {
+		// Only network routes have a network range
+		data.Network = nullStringToEmptyString(derefString(responseData.Network))
+	}
+
+	domainsList, newDiags := convertStringSliceToListValue(domains)
+	diags.Append(newDiags...)
+	data.Domains = preserveNullList(domainsList, data.Domains)
+	peerGroups, newDiags := convertStringSliceToListValue(derefStringSlice(responseData.PeerGroups))
+	diags.Append(newDiags...)
+	data.PeerGroups = preserveNullList(peerGroups, data.PeerGroups)
+	data.Groups, newDiags = types.ListValueFrom(ctx, types.StringType, responseData.Groups)
+	diags.Append(newDiags...)
+
+	// The API returns an empty list when no access control groups are set
+	accessControlGroups := derefStringSlice(responseData.AccessControlGroups)
+	if len(accessControlGroups) == 0 && data.AccessControlGroups.IsNull() {
+		data.AccessControlGroups = types.SetNull(types.StringType)
+	} else {
+		data.AccessControlGroups, newDiags = types.SetValueFrom(ctx, types.StringType, append([]string{}, accessControlGroups...))
+		diags.Append(newDiags...)
+	}
+
+	return diags
+}
+
+func routeModelToApiRequest(data RouteResourceModel) (*netbirdApi.RouteRequest, diag.Diagnostics) {
+	groups, diags := convertListToStringSlice(data.Groups)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	request := &netbirdApi.RouteRequest{
+		NetworkId:   data.NetworkID.ValueString(),
+		Description: data.Description.ValueString(),
+		KeepRoute:   data.KeepRoute.ValueBool(),
+		Groups:      groups,
+		Metric:      int(data.Metric.ValueInt32()),
+		Masquerade:  data.Masquerade.ValueBool(),
+		Enabled:     data.Enabled.ValueBool(),
+	}
+
+	// Only one of each pair may be sent to the API
+	if data.Network.ValueString() != "" {
+		request.Network = data.Network.ValueStringPointer()
+	} else {
+		domains, diags := convertListToStringSlice(data.Domains)
+		if diags.HasError() {
+			return nil, diags
+		}
+		request.Domains = &domains
+	}
+
+	if data.Peer.ValueString() != "" {
+		request.Peer = data.Peer.ValueStringPointer()
+	} else {
+		peerGroups, diags := convertListToStringSlice(data.PeerGroups)
+		if diags.HasError() {
+			return nil, diags
+		}
+		request.PeerGroups = &peerGroups
+	}
+
+	if !data.AccessControlGroups.IsNull() {
+		accessControlGroups, diags := convertSetToStringSlice(data.AccessControlGroups)
+		if diags.HasError() {
+			return nil, diags
+		}
+		request.AccessControlGroups = &accessControlGroups
+	}
+
+	return request, diags
+}
+
+func (r *RouteResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data RouteResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiData, diags := routeModelToApiRequest(data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := putJSON[netbirdApi.Route](ctx, r.client, fmt.Sprintf("/api/routes/%s", data.ID.ValueString()), apiData)
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating route", err.Error())
+		return
+	}
+
+	diags = r.readRouteIntoModel(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *RouteResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data RouteResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := deleteObject(ctx, r.client, fmt.Sprintf("/api/routes/%s", data.ID.ValueString()))
+	if err != nil {
+		resp.Diagnostics.AddError("Error deleting route", err.Error())
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *RouteResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
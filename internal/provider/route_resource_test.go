@@ -0,0 +1,308 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+func TestAccRouteResource_domains(t *testing.T) {
+	providerConfig, mock := testAccProviderConfig(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckDestroy(mock, "netbird_route", staticPath("/api/routes")),
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + testAccRouteResourceDomainsConfig(`["example.com"]`, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("netbird_route.test", "network_id", "tf-acc-domains"),
+					resource.TestCheckResourceAttr("netbird_route.test", "domains.#", "1"),
+					resource.TestCheckResourceAttr("netbird_route.test", "domains.0", "example.com"),
+					resource.TestCheckResourceAttr("netbird_route.test", "keep_route", "true"),
+					resource.TestCheckResourceAttr("netbird_route.test", "network_type", "Domain"),
+					resource.TestCheckNoResourceAttr("netbird_route.test", "network"),
+					resource.TestCheckResourceAttr("netbird_route.test", "metric", "9999"),
+					resource.TestCheckResourceAttrPair("netbird_route.test", "peer_groups.0", "netbird_group.test", "id"),
+					resource.TestCheckResourceAttrPair("netbird_route.test", "groups.0", "netbird_group.test", "id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "netbird_route.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + testAccRouteResourceDomainsConfig(`["example.com", "*.example.org"]`, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("netbird_route.test", "domains.#", "2"),
+					resource.TestCheckResourceAttr("netbird_route.test", "domains.1", "*.example.org"),
+					resource.TestCheckResourceAttr("netbird_route.test", "keep_route", "false"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRouteResource_network(t *testing.T) {
+	providerConfig, mock := testAccProviderConfig(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckDestroy(mock, "netbird_route", staticPath("/api/routes")),
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + testAccRouteResourceNetworkConfig("10.10.0.0/16"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("netbird_route.test", "network", "10.10.0.0/16"),
+					resource.TestCheckResourceAttr("netbird_route.test", "network_type", "IPv4"),
+					resource.TestCheckResourceAttr("netbird_route.test", "keep_route", "false"),
+					resource.TestCheckNoResourceAttr("netbird_route.test", "domains.#"),
+				),
+			},
+			{
+				ResourceName:      "netbird_route.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccRouteResource_switchType(t *testing.T) {
+	providerConfig, mock := testAccProviderConfig(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckDestroy(mock, "netbird_route", staticPath("/api/routes")),
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + testAccRouteResourceNetworkConfig("10.10.0.0/16"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("netbird_route.test", "network", "10.10.0.0/16"),
+				),
+			},
+			// A network route becomes a domain route in place, without the
+			// network the API returns for domain routes showing as a diff
+			{
+				Config: providerConfig + testAccRouteResourceDomainsConfig(`["example.com"]`, true),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("netbird_route.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("netbird_route.test", "network"),
+					resource.TestCheckResourceAttr("netbird_route.test", "domains.#", "1"),
+					resource.TestCheckResourceAttr("netbird_route.test", "keep_route", "true"),
+					resource.TestCheckResourceAttr("netbird_route.test", "network_type", "Domain"),
+				),
+			},
+			// and back
+			{
+				Config: providerConfig + testAccRouteResourceNetworkConfig("10.20.0.0/16"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("netbird_route.test", "network", "10.20.0.0/16"),
+					resource.TestCheckNoResourceAttr("netbird_route.test", "domains.#"),
+					resource.TestCheckResourceAttr("netbird_route.test", "keep_route", "false"),
+					resource.TestCheckResourceAttr("netbird_route.test", "network_type", "IPv4"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRouteResource_accessControlGroups(t *testing.T) {
+	providerConfig, mock := testAccProviderConfig(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckDestroy(mock, "netbird_route", staticPath("/api/routes")),
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + testAccRouteResourceAccessControlGroupsConfig("[netbird_group.exit_node_users.id]"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("netbird_route.test", "access_control_groups.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair("netbird_route.test", "access_control_groups.*", "netbird_group.exit_node_users", "id"),
+				),
+			},
+			{
+				Config: providerConfig + testAccRouteResourceAccessControlGroupsConfig("[netbird_group.test.id, netbird_group.exit_node_users.id]"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("netbird_route.test", "access_control_groups.#", "2"),
+					resource.TestCheckTypeSetElemAttrPair("netbird_route.test", "access_control_groups.*", "netbird_group.test", "id"),
+					resource.TestCheckTypeSetElemAttrPair("netbird_route.test", "access_control_groups.*", "netbird_group.exit_node_users", "id"),
+				),
+			},
+			// The order of the groups does not matter
+			{
+				Config:   providerConfig + testAccRouteResourceAccessControlGroupsConfig("[netbird_group.exit_node_users.id, netbird_group.test.id]"),
+				PlanOnly: true,
+			},
+			{
+				ResourceName:      "netbird_route.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Removing the attribute clears the groups
+			{
+				Config: providerConfig + testAccRouteResourceAccessControlGroupsConfig("null"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("netbird_route.test", "access_control_groups.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccRouteResourceAccessControlGroupsConfig(accessControlGroups string) string {
+	return fmt.Sprintf(`
+resource "netbird_group" "test" {
+  name = "tf-acc-route-group"
+}
+
+resource "netbird_group" "exit_node_users" {
+  name = "tf-acc-route-exit-node-users"
+}
+
+resource "netbird_route" "test" {
+  network_id            = "tf-acc-exit-node"
+  network               = "0.0.0.0/0"
+  peer_groups           = [netbird_group.test.id]
+  groups                = [netbird_group.test.id]
+  access_control_groups = %s
+  masquerade            = true
+  enabled               = true
+}
+`, accessControlGroups)
+}
+
+func TestAccRouteResource_validation(t *testing.T) {
+	providerConfig, _ := testAccProviderConfig(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      providerConfig + testAccRouteResourceValidationConfig(`network = "10.0.0.0/8"`+"\n"+`domains = ["example.com"]`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("Conflicting route destination"),
+			},
+			{
+				Config:      providerConfig + testAccRouteResourceValidationConfig(""),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("Missing route destination"),
+			},
+			{
+				Config:      providerConfig + testAccRouteResourceValidationConfig(`network = "10.0.0.0/8"`+"\n"+`keep_route = true`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("Unexpected keep_route"),
+			},
+			{
+				Config:      providerConfig + testAccRouteResourceValidationConfig(`network = "10.0.0.0"`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("Invalid network"),
+			},
+			{
+				Config:      providerConfig + testAccRouteResourceValidationConfig(`domains = ["not a domain"]`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("Invalid domain"),
+			},
+		},
+	})
+}
+
+func TestAccRouteResource_peerValidation(t *testing.T) {
+	providerConfig, _ := testAccProviderConfig(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      providerConfig + testAccRouteResourcePeersConfig(""),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("Exactly one of peer or peer_groups must be specified"),
+			},
+			{
+				Config:      providerConfig + testAccRouteResourcePeersConfig(`peer = "peer-id"`+"\n"+`peer_groups = ["group-id"]`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("Exactly one of peer or peer_groups must be specified"),
+			},
+		},
+	})
+}
+
+func testAccRouteResourceDomainsConfig(domains string, keepRoute bool) string {
+	return fmt.Sprintf(`
+resource "netbird_group" "test" {
+  name = "tf-acc-route-group"
+}
+
+resource "netbird_route" "test" {
+  network_id  = "tf-acc-domains"
+  description = "Terraform acceptance test"
+  domains     = %s
+  keep_route  = %t
+  peer_groups = [netbird_group.test.id]
+  groups      = [netbird_group.test.id]
+  masquerade  = true
+  enabled     = true
+}
+`, domains, keepRoute)
+}
+
+func testAccRouteResourceNetworkConfig(network string) string {
+	return fmt.Sprintf(`
+resource "netbird_group" "test" {
+  name = "tf-acc-route-group"
+}
+
+resource "netbird_route" "test" {
+  network_id  = "tf-acc-network"
+  network     = %q
+  peer_groups = [netbird_group.test.id]
+  groups      = [netbird_group.test.id]
+  masquerade  = false
+  enabled     = true
+}
+`, network)
+}
+
+func testAccRouteResourceValidationConfig(destination string) string {
+	return fmt.Sprintf(`
+resource "netbird_route" "test" {
+  network_id  = "tf-acc-invalid"
+  %s
+  peer_groups = ["group-id"]
+  groups      = ["group-id"]
+  masquerade  = true
+  enabled     = true
+}
+`, destination)
+}
+
+func testAccRouteResourcePeersConfig(peers string) string {
+	return fmt.Sprintf(`
+resource "netbird_route" "test" {
+  network_id = "tf-acc-invalid"
+  network    = "10.0.0.0/8"
+  %s
+  groups     = ["group-id"]
+  masquerade = true
+  enabled    = true
+}
+`, peers)
+}
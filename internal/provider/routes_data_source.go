@@ -0,0 +1,211 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	netbirdApi "github.com/netbirdio/netbird/management/server/http/api"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &RoutesDataSource{}
+
+func NewRoutesDataSource() datasource.DataSource {
+	return &RoutesDataSource{}
+}
+
+// RoutesDataSource lists the routes of the account.
+type RoutesDataSource struct {
+	client ClientInterface
+}
+
+func (d *RoutesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_routes"
+}
+
+func (d *RoutesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Retrieve the routes of the account, optionally filtered by status and network type.",
+
+		Attributes: map[string]schema.Attribute{
+			"enabled": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Only return routes with this status.",
+			},
+			"network_type": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only return routes of this network type, one of IPv4, IPv6 or Domain.",
+				Validators: []validator.String{
+					routeNetworkTypeValidator,
+				},
+			},
+			"routes": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Routes matching the filters.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "Unique identifier of the route.",
+						},
+						"network_id": schema.StringAttribute{
+							Computed:    true,
+							Description: "Route network identifier, shared by the routes of a highly available route.",
+						},
+						"description": schema.StringAttribute{
+							Computed:    true,
+							Description: "Description of the route.",
+						},
+						"network": schema.StringAttribute{
+							Computed:    true,
+							Description: "Network range in CIDR format. Null for domain routes.",
+						},
+						"domains": schema.ListAttribute{
+							ElementType: types.StringType,
+							Computed:    true,
+							Description: "Domains routed by a domain route.",
+						},
+						"keep_route": schema.BoolAttribute{
+							Computed:    true,
+							Description: "Indicates whether routes to addresses a domain no longer resolves to are kept.",
+						},
+						"network_type": schema.StringAttribute{
+							Computed:    true,
+							Description: "Network type of the route, one of IPv4, IPv6 or Domain.",
+						},
+						"peer": schema.StringAttribute{
+							Computed:    true,
+							Description: "Routing peer ID. Null when routing through peer groups.",
+						},
+						"peer_groups": schema.ListAttribute{
+							ElementType: types.StringType,
+							Computed:    true,
+							Description: "Group IDs of the routing peers.",
+						},
+						"groups": schema.ListAttribute{
+							ElementType: types.StringType,
+							Computed:    true,
+							Description: "Group IDs of the peers the route is distributed to.",
+						},
+						"access_control_groups": schema.ListAttribute{
+							ElementType: types.StringType,
+							Computed:    true,
+							Description: "Group IDs used as the destination of policies controlling access to the route.",
+						},
+						"metric": schema.Int64Attribute{
+							Computed:    true,
+							Description: "Route metric, routes with a lower metric have a higher priority.",
+						},
+						"masquerade": schema.BoolAttribute{
+							Computed:    true,
+							Description: "Indicates whether routing peers masquerade traffic to the route.",
+						},
+						"enabled": schema.BoolAttribute{
+							Computed:    true,
+							Description: "Indicates whether the route is enabled.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *RoutesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(ClientInterface)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *RoutesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data RoutesDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	routes, err := getJSON[[]netbirdApi.Route](ctx, d.client, "/api/routes")
+	if err != nil {
+		resp.Diagnostics.AddError("Error Making API Request", err.Error())
+		return
+	}
+
+	data.Routes = []RouteDataSourceModel{}
+	if routes != nil {
+		for _, route := range *routes {
+			if !data.Enabled.IsNull() && route.Enabled != data.Enabled.ValueBool() {
+				continue
+			}
+			if !data.NetworkType.IsNull() && route.NetworkType != data.NetworkType.ValueString() {
+				continue
+			}
+			model, diags := convertRouteToDataSourceModel(ctx, route)
+			resp.Diagnostics.Append(diags...)
+			data.Routes = append(data.Routes, model)
+		}
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func convertRouteToDataSourceModel(ctx context.Context, route netbirdApi.Route) (RouteDataSourceModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	model := RouteDataSourceModel{
+		ID:          types.StringValue(route.Id),
+		NetworkID:   types.StringValue(route.NetworkId),
+		Description: types.StringValue(route.Description),
+		Network:     types.StringNull(),
+		KeepRoute:   types.BoolValue(route.KeepRoute),
+		NetworkType: types.StringValue(route.NetworkType),
+		Peer:        nullStringToEmptyString(derefString(route.Peer)),
+		Metric:      types.Int64Value(int64(route.Metric)),
+		Masquerade:  types.BoolValue(route.Masquerade),
+		Enabled:     types.BoolValue(route.Enabled),
+	}
+
+	domains := derefStringSlice(route.Domains)
+	if len(domains) == 0 {
+		// Only network routes have a network range
+		model.Network = nullStringToEmptyString(derefString(route.Network))
+	}
+
+	// Absent lists are returned as empty lists
+	var newDiags diag.Diagnostics
+	model.Domains, newDiags = types.ListValueFrom(ctx, types.StringType, append([]string{}, domains...))
+	diags.Append(newDiags...)
+	model.PeerGroups, newDiags = types.ListValueFrom(ctx, types.StringType, append([]string{}, derefStringSlice(route.PeerGroups)...))
+	diags.Append(newDiags...)
+	model.Groups, newDiags = types.ListValueFrom(ctx, types.StringType, append([]string{}, route.Groups...))
+	diags.Append(newDiags...)
+	model.AccessControlGroups, newDiags = types.ListValueFrom(ctx, types.StringType, append([]string{}, derefStringSlice(route.AccessControlGroups)...))
+	diags.Append(newDiags...)
+
+	return model, diags
+}
@@ -0,0 +1,98 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccRoutesDataSource(t *testing.T) {
+	testAccMockOnly(t)
+	providerConfig, mock := testAccProviderConfig(t)
+
+	mock.Seed("/api/routes", map[string]any{
+		"network_id":  "office",
+		"description": "Office network",
+		"network":     "10.10.0.0/16",
+		"peer":        "peer-1",
+		"groups":      []string{"group-1"},
+		"metric":      100,
+		"masquerade":  true,
+		"enabled":     true,
+	})
+	mock.Seed("/api/routes", map[string]any{
+		"network_id":  "saas",
+		"domains":     []string{"example.com"},
+		"keep_route":  true,
+		"peer_groups": []string{"group-2"},
+		"groups":      []string{"group-1"},
+		"metric":      9999,
+		"masquerade":  true,
+		"enabled":     true,
+	})
+	mock.Seed("/api/routes", map[string]any{
+		"network_id":  "legacy",
+		"network":     "fd00::/64",
+		"peer_groups": []string{"group-2"},
+		"groups":      []string{"group-1"},
+		"metric":      9999,
+		"enabled":     false,
+	})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+data "netbird_routes" "all" {}
+
+data "netbird_routes" "enabled" {
+  enabled = true
+}
+
+data "netbird_routes" "domains" {
+  network_type = "Domain"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.netbird_routes.all", "routes.#", "3"),
+					resource.TestCheckResourceAttr("data.netbird_routes.all", "routes.0.network_id", "office"),
+					resource.TestCheckResourceAttr("data.netbird_routes.all", "routes.0.network", "10.10.0.0/16"),
+					resource.TestCheckResourceAttr("data.netbird_routes.all", "routes.0.network_type", "IPv4"),
+					resource.TestCheckResourceAttr("data.netbird_routes.all", "routes.0.peer", "peer-1"),
+					resource.TestCheckResourceAttr("data.netbird_routes.all", "routes.0.peer_groups.#", "0"),
+					resource.TestCheckResourceAttr("data.netbird_routes.all", "routes.0.metric", "100"),
+					resource.TestCheckResourceAttr("data.netbird_routes.all", "routes.2.network_type", "IPv6"),
+					resource.TestCheckResourceAttr("data.netbird_routes.enabled", "routes.#", "2"),
+					resource.TestCheckResourceAttr("data.netbird_routes.domains", "routes.#", "1"),
+					resource.TestCheckResourceAttr("data.netbird_routes.domains", "routes.0.network_id", "saas"),
+					resource.TestCheckNoResourceAttr("data.netbird_routes.domains", "routes.0.network"),
+					resource.TestCheckResourceAttr("data.netbird_routes.domains", "routes.0.domains.0", "example.com"),
+					resource.TestCheckResourceAttr("data.netbird_routes.domains", "routes.0.keep_route", "true"),
+					resource.TestCheckResourceAttr("data.netbird_routes.domains", "routes.0.peer_groups.0", "group-2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRoutesDataSource_invalidNetworkType(t *testing.T) {
+	providerConfig, _ := testAccProviderConfig(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+data "netbird_routes" "test" {
+  network_type = "domain"
+}
+`,
+				ExpectError: regexp.MustCompile("must be one of IPv4, IPv6 or Domain"),
+			},
+		},
+	})
+}
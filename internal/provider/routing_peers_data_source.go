@@ -0,0 +1,109 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	netbirdApi "github.com/netbirdio/netbird/management/server/http/api"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &RoutingPeersDataSource{}
+
+func NewRoutingPeersDataSource() datasource.DataSource {
+	return &RoutingPeersDataSource{}
+}
+
+// RoutingPeersDataSource counts the routing peers of all networks in the account.
+type RoutingPeersDataSource struct {
+	client ClientInterface
+}
+
+func (d *RoutingPeersDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_routing_peers"
+}
+
+func (d *RoutingPeersDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Retrieve the number of routing peers of the account, in total and per network. " +
+			"Useful for capacity planning and monitoring.",
+
+		Attributes: map[string]schema.Attribute{
+			"total_routing_peers_count": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Number of routing peers across all networks.",
+			},
+			"networks": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Routing peers of each network, in the order the API lists the networks.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "Unique identifier of the network.",
+						},
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "Name of the network.",
+						},
+						"routing_peers_count": schema.Int64Attribute{
+							Computed:    true,
+							Description: "Number of routing peers in the network.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *RoutingPeersDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(ClientInterface)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *RoutingPeersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data RoutingPeersDataSourceModel
+
+	networks, err := getJSON[[]netbirdApi.Network](ctx, d.client, "/api/networks")
+	if err != nil {
+		resp.Diagnostics.AddError("Error Making API Request", err.Error())
+		return
+	}
+
+	total := 0
+	data.Networks = []NetworkRoutingPeersDataSourceModel{}
+	if networks != nil {
+		for _, network := range *networks {
+			total += network.RoutingPeersCount
+			data.Networks = append(data.Networks, NetworkRoutingPeersDataSourceModel{
+				ID:                types.StringValue(network.Id),
+				Name:              types.StringValue(network.Name),
+				RoutingPeersCount: types.Int64Value(int64(network.RoutingPeersCount)),
+			})
+		}
+	}
+	data.TotalRoutingPeersCount = types.Int64Value(int64(total))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,37 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccRoutingPeersDataSource(t *testing.T) {
+	providerConfig, mock := testAccProviderConfig(t)
+	office := mock.Seed("/api/networks", map[string]any{"name": "office"})
+	mock.Seed("/api/networks/"+office+"/routers", map[string]any{"peer": "peer1", "metric": 100, "masquerade": true, "enabled": true})
+	mock.Seed("/api/networks/"+office+"/routers", map[string]any{"peer": "peer2", "metric": 100, "masquerade": true, "enabled": true})
+	datacenter := mock.Seed("/api/networks", map[string]any{"name": "datacenter"})
+	mock.Seed("/api/networks/"+datacenter+"/routers", map[string]any{"peer": "peer3", "metric": 100, "masquerade": true, "enabled": true})
+	mock.Seed("/api/networks", map[string]any{"name": "unrouted"})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `data "netbird_routing_peers" "test" {}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.netbird_routing_peers.test", "total_routing_peers_count", "3"),
+					resource.TestCheckResourceAttr("data.netbird_routing_peers.test", "networks.#", "3"),
+					resource.TestCheckResourceAttr("data.netbird_routing_peers.test", "networks.0.id", office),
+					resource.TestCheckResourceAttr("data.netbird_routing_peers.test", "networks.0.name", "office"),
+					resource.TestCheckResourceAttr("data.netbird_routing_peers.test", "networks.0.routing_peers_count", "2"),
+					resource.TestCheckResourceAttr("data.netbird_routing_peers.test", "networks.1.routing_peers_count", "1"),
+					resource.TestCheckResourceAttr("data.netbird_routing_peers.test", "networks.2.name", "unrouted"),
+					resource.TestCheckResourceAttr("data.netbird_routing_peers.test", "networks.2.routing_peers_count", "0"),
+				),
+			},
+		},
+	})
+}
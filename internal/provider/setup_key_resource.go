@@ -0,0 +1,468 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	netbirdApi "github.com/netbirdio/netbird/management/server/http/api"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &SetupKeyResource{}
+var _ resource.ResourceWithImportState = &SetupKeyResource{}
+var _ resource.ResourceWithIdentity = &SetupKeyResource{}
+var _ resource.ResourceWithConfigValidators = &SetupKeyResource{}
+
+func NewSetupKeyResource() resource.Resource {
+	return &SetupKeyResource{}
+}
+
+// SetupKeyResource defines the resource implementation.
+type SetupKeyResource struct {
+	client ClientInterface
+}
+
+type SetupKeyResourceModel struct {
+	ID                  types.String `tfsdk:"id"`
+	Name                types.String `tfsdk:"name"`
+	Type                types.String `tfsdk:"type"`
+	ExpiresIn           types.Int64  `tfsdk:"expires_in"`
+	UsageLimit          types.Int64  `tfsdk:"usage_limit"`
+	Ephemeral           types.Bool   `tfsdk:"ephemeral"`
+	AllowExtraDnsLabels types.Bool   `tfsdk:"allow_extra_dns_labels"`
+	AutoGroups          types.List   `tfsdk:"auto_groups"`
+	AutoGroupNames      types.List   `tfsdk:"auto_group_names"`
+	Revoked             types.Bool   `tfsdk:"revoked"`
+	Key                 types.String `tfsdk:"key"`
+	Expires             types.String `tfsdk:"expires"`
+	State               types.String `tfsdk:"state"`
+	Valid               types.Bool   `tfsdk:"valid"`
+	UsedTimes           types.Int64  `tfsdk:"used_times"`
+}
+
+func (r *SetupKeyResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_setup_key"
+}
+
+func (r *SetupKeyResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Setup Key resource",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Setup Key ID",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Setup Key name",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "Setup Key type, `one-off` for single time usage or `reusable`",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"expires_in": schema.Int64Attribute{
+				MarkdownDescription: "Expiration time in seconds from creation, e.g. `2592000` for 30 days. `0`, the default, means the key never expires",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(0),
+				Validators: []validator.Int64{
+					int64AtLeastValidator{min: 0},
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"usage_limit": schema.Int64Attribute{
+				MarkdownDescription: "Number of times the key can be used. 0 means unlimited usage",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(0),
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"ephemeral": schema.BoolAttribute{
+				MarkdownDescription: "Indicate that peers registered with the key are ephemeral",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"allow_extra_dns_labels": schema.BoolAttribute{
+				MarkdownDescription: "Allow extra DNS labels to be added to peers registered with the key",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"auto_groups": schema.ListAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "Group IDs to auto-assign to peers registered with the key. Conflicts with `auto_group_names`",
+				Optional:            true,
+			},
+			"auto_group_names": schema.ListAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "Group names to auto-assign to peers registered with the key, resolved to IDs at apply time. Conflicts with `auto_groups`",
+				Optional:            true,
+			},
+			"revoked": schema.BoolAttribute{
+				MarkdownDescription: "Setup Key revocation status",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"key": schema.StringAttribute{
+				MarkdownDescription: "Setup Key secret, as used by `netbird up --setup-key`. " +
+					"Only known when the key is created by Terraform and not refreshed afterwards, so it is null for imported keys",
+				Computed:  true,
+				Sensitive: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"expires": schema.StringAttribute{
+				MarkdownDescription: "Setup Key expiration date",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"state": schema.StringAttribute{
+				MarkdownDescription: "Setup Key status, `valid`, `overused`, `expired` or `revoked`",
+				Computed:            true,
+			},
+			"valid": schema.BoolAttribute{
+				MarkdownDescription: "Setup Key validity status",
+				Computed:            true,
+			},
+			"used_times": schema.Int64Attribute{
+				MarkdownDescription: "Usage count of the Setup Key",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *SetupKeyResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		setupKeyAutoGroupsValidator{},
+	}
+}
+
+// setupKeyAutoGroupsValidator checks that auto groups are given either by ID or by name.
+type setupKeyAutoGroupsValidator struct{}
+
+func (v setupKeyAutoGroupsValidator) Description(ctx context.Context) string {
+	return "auto_groups and auto_group_names cannot both be set"
+}
+
+func (v setupKeyAutoGroupsValidator) MarkdownDescription(ctx context.Context) string {
+	return "`auto_groups` and `auto_group_names` cannot both be set"
+}
+
+func (v setupKeyAutoGroupsValidator) ValidateResource(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var autoGroups, autoGroupNames types.List
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("auto_groups"), &autoGroups)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("auto_group_names"), &autoGroupNames)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !autoGroups.IsNull() && !autoGroupNames.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("auto_group_names"),
+			"Conflicting auto groups",
+			"Auto groups can be given either by ID with auto_groups or by name with auto_group_names, but not both.",
+		)
+	}
+}
+
+func (r *SetupKeyResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentitySchema("setup key")
+}
+
+func (r *SetupKeyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(ClientInterface)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *SetupKeyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data SetupKeyResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	autoGroups, diags := r.resolveAutoGroups(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Make API request
+	responseData, err := postJSON[netbirdApi.SetupKeyClear](ctx, r.client, "/api/setup-keys", netbirdApi.CreateSetupKeyRequest{
+		Name:                data.Name.ValueString(),
+		Type:                data.Type.ValueString(),
+		ExpiresIn:           int(data.ExpiresIn.ValueInt64()),
+		UsageLimit:          int(data.UsageLimit.ValueInt64()),
+		Ephemeral:           data.Ephemeral.ValueBoolPointer(),
+		AllowExtraDnsLabels: data.AllowExtraDnsLabels.ValueBoolPointer(),
+		AutoGroups:          autoGroups,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error making API request", err.Error())
+		return
+	}
+
+	// Assign values from API response. The plain text key is only returned on creation.
+	data.ID = types.StringValue(responseData.Id)
+	data.Key = types.StringValue(responseData.Key)
+
+	// Keys are created unrevoked, so apply the revocation status separately
+	if data.Revoked.ValueBool() {
+		resp.Diagnostics.Append(r.updateSetupKey(ctx, &data)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	diags = r.readIntoModel(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIDIdentity(ctx, resp.Identity, data.ID)...)
+}
+
+func (r *SetupKeyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data SetupKeyResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The framework requires an identity even when the object has been deleted
+	resp.Diagnostics.Append(setIDIdentity(ctx, resp.Identity, data.ID)...)
+
+	diags := r.readIntoModel(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The object has been deleted outside of Terraform
+	if data.ID.IsNull() {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SetupKeyResource) readIntoModel(ctx context.Context, data *SetupKeyResourceModel) diag.Diagnostics {
+	// Fetch data from API
+	diags := diag.Diagnostics{}
+	responseData, err := getJSON[netbirdApi.SetupKey](ctx, r.client, fmt.Sprintf("/api/setup-keys/%s", data.ID.ValueString()))
+	if err != nil {
+		diags.AddError("Error fetching setup key", err.Error())
+		return diags
+	}
+
+	// Handle when resource does not exist
+	if responseData == nil {
+		data.ID = types.StringNull()
+		return diags
+	}
+
+	// Update state with latest data. The key is masked when read back, so the
+	// value from creation is kept.
+	data.Name = types.StringValue(responseData.Name)
+	data.Type = types.StringValue(responseData.Type)
+	data.UsageLimit = types.Int64Value(int64(responseData.UsageLimit))
+	data.Ephemeral = types.BoolValue(responseData.Ephemeral)
+	data.AllowExtraDnsLabels = types.BoolValue(responseData.AllowExtraDnsLabels)
+	data.Revoked = types.BoolValue(responseData.Revoked)
+	data.Expires = types.StringValue(responseData.Expires.Format(time.RFC3339))
+	data.State = types.StringValue(responseData.State)
+	data.Valid = types.BoolValue(responseData.Valid)
+	data.UsedTimes = types.Int64Value(int64(responseData.UsedTimes))
+	if data.Key.IsUnknown() {
+		data.Key = types.StringNull()
+	}
+	if data.ExpiresIn.IsNull() || data.ExpiresIn.IsUnknown() {
+		// Not returned by the API, e.g. after import
+		data.ExpiresIn = types.Int64Value(0)
+	}
+
+	// Keep tracking groups by name when they were configured by name
+	if !data.AutoGroupNames.IsNull() {
+		groups, err := listGroups(ctx, r.client)
+		if err != nil {
+			diags.AddError("Error fetching groups", err.Error())
+			return diags
+		}
+		namesByID := map[string]string{}
+		for _, group := range groups {
+			namesByID[group.Id] = group.Name
+		}
+		names := []string{}
+		for _, id := range responseData.AutoGroups {
+			if name, ok := namesByID[id]; ok {
+				names = append(names, name)
+			} else {
+				names = append(names, id)
+			}
+		}
+		autoGroupNames, newDiags := types.ListValueFrom(ctx, types.StringType, names)
+		diags.Append(newDiags...)
+		data.AutoGroupNames = autoGroupNames
+		return diags
+	}
+
+	autoGroups, newDiags := convertStringSliceToListValue(responseData.AutoGroups)
+	diags.Append(newDiags...)
+	data.AutoGroups = preserveNullList(autoGroups, data.AutoGroups)
+
+	return diags
+}
+
+// resolveAutoGroups returns the IDs of the auto groups, looking up groups
+// configured by name.
+func (r *SetupKeyResource) resolveAutoGroups(ctx context.Context, data *SetupKeyResourceModel) ([]string, diag.Diagnostics) {
+	if data.AutoGroupNames.IsNull() || data.AutoGroupNames.IsUnknown() {
+		return convertListToStringSlice(data.AutoGroups)
+	}
+
+	names, diags := convertListToStringSlice(data.AutoGroupNames)
+	if diags.HasError() {
+		return nil, diags
+	}
+	ids, err := resolveGroupNames(ctx, r.client, names)
+	if err != nil {
+		diags.AddAttributeError(path.Root("auto_group_names"), "Error resolving group names", err.Error())
+		return nil, diags
+	}
+	if ids == nil {
+		ids = []string{}
+	}
+	return ids, diags
+}
+
+// updateSetupKey updates the mutable attributes of a setup key.
+func (r *SetupKeyResource) updateSetupKey(ctx context.Context, data *SetupKeyResourceModel) diag.Diagnostics {
+	autoGroups, diags := r.resolveAutoGroups(ctx, data)
+	if diags.HasError() {
+		return diags
+	}
+
+	_, err := putJSON[netbirdApi.SetupKey](ctx, r.client, fmt.Sprintf("/api/setup-keys/%s", data.ID.ValueString()), netbirdApi.SetupKeyRequest{
+		AutoGroups: autoGroups,
+		Revoked:    data.Revoked.ValueBool(),
+	})
+	if err != nil {
+		diags.AddError("Error updating setup key", err.Error())
+	}
+	return diags
+}
+
+func (r *SetupKeyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data SetupKeyResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.updateSetupKey(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags := r.readIntoModel(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(setIDIdentity(ctx, resp.Identity, data.ID)...)
+}
+
+func (r *SetupKeyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data SetupKeyResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := deleteObject(ctx, r.client, fmt.Sprintf("/api/setup-keys/%s", data.ID.ValueString()))
+	if err != nil {
+		resp.Diagnostics.AddError("Error deleting setup key", err.Error())
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *SetupKeyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
+}
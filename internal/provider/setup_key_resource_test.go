@@ -0,0 +1,162 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccSetupKeyResource(t *testing.T) {
+	providerConfig, mock := testAccProviderConfig(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckDestroy(mock, "netbird_setup_key", staticPath("/api/setup-keys")),
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + testAccSetupKeyResourceConfig(false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("netbird_setup_key.test", "name", "tf-acc-setup-key"),
+					resource.TestCheckResourceAttr("netbird_setup_key.test", "type", "reusable"),
+					resource.TestCheckResourceAttr("netbird_setup_key.test", "usage_limit", "5"),
+					resource.TestCheckResourceAttr("netbird_setup_key.test", "ephemeral", "true"),
+					resource.TestCheckResourceAttr("netbird_setup_key.test", "revoked", "false"),
+					resource.TestCheckResourceAttr("netbird_setup_key.test", "valid", "true"),
+					resource.TestCheckResourceAttr("netbird_setup_key.test", "state", "valid"),
+					resource.TestCheckResourceAttr("netbird_setup_key.test", "auto_groups.#", "1"),
+					resource.TestCheckResourceAttrPair("netbird_setup_key.test", "auto_groups.0", "netbird_group.test", "id"),
+					resource.TestCheckResourceAttrSet("netbird_setup_key.test", "key"),
+					resource.TestCheckResourceAttrSet("netbird_setup_key.test", "expires"),
+				),
+			},
+			// ImportState testing, the key secret is not readable after creation
+			{
+				ResourceName:            "netbird_setup_key.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"key", "expires_in"},
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + testAccSetupKeyResourceConfig(true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("netbird_setup_key.test", "revoked", "true"),
+					resource.TestCheckResourceAttr("netbird_setup_key.test", "state", "revoked"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccSetupKeyResourceConfig(revoked bool) string {
+	return fmt.Sprintf(`
+resource "netbird_group" "test" {
+  name = "tf-acc-setup-key-group"
+}
+
+resource "netbird_setup_key" "test" {
+  name        = "tf-acc-setup-key"
+  type        = "reusable"
+  expires_in  = 86400
+  usage_limit = 5
+  ephemeral   = true
+  auto_groups = [netbird_group.test.id]
+  revoked     = %t
+}
+`, revoked)
+}
+
+func TestAccSetupKeyResource_autoGroupNames(t *testing.T) {
+	providerConfig, mock := testAccProviderConfig(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckDestroy(mock, "netbird_setup_key", staticPath("/api/setup-keys")),
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + testAccSetupKeyResourceAutoGroupNamesConfig(`["tf-acc-setup-key-a"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("netbird_setup_key.test", "auto_group_names.#", "1"),
+					resource.TestCheckResourceAttr("netbird_setup_key.test", "auto_group_names.0", "tf-acc-setup-key-a"),
+					resource.TestCheckNoResourceAttr("netbird_setup_key.test", "auto_groups"),
+				),
+			},
+			{
+				Config: providerConfig + testAccSetupKeyResourceAutoGroupNamesConfig(`["tf-acc-setup-key-a", "tf-acc-setup-key-b"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("netbird_setup_key.test", "auto_group_names.#", "2"),
+					resource.TestCheckResourceAttr("netbird_setup_key.test", "auto_group_names.1", "tf-acc-setup-key-b"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSetupKeyResource_conflictingAutoGroups(t *testing.T) {
+	providerConfig, _ := testAccProviderConfig(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+resource "netbird_setup_key" "test" {
+  name             = "tf-acc-setup-key"
+  type             = "reusable"
+  auto_groups      = ["group-id"]
+  auto_group_names = ["group-name"]
+}
+`,
+				ExpectError: regexp.MustCompile("Conflicting auto groups"),
+			},
+		},
+	})
+}
+
+func testAccSetupKeyResourceAutoGroupNamesConfig(names string) string {
+	return fmt.Sprintf(`
+resource "netbird_group" "a" {
+  name = "tf-acc-setup-key-a"
+}
+
+resource "netbird_group" "b" {
+  name = "tf-acc-setup-key-b"
+}
+
+resource "netbird_setup_key" "test" {
+  name             = "tf-acc-setup-key-names"
+  type             = "reusable"
+  auto_group_names = %s
+
+  depends_on = [netbird_group.a, netbird_group.b]
+}
+`, names)
+}
+
+func TestAccSetupKeyResource_invalidExpiresIn(t *testing.T) {
+	providerConfig, _ := testAccProviderConfig(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+resource "netbird_setup_key" "invalid" {
+  name       = "tf-acc-setup-key-invalid"
+  type       = "one-off"
+  expires_in = -1
+}
+`,
+				ExpectError: regexp.MustCompile("value must be at least 0"),
+			},
+		},
+	})
+}
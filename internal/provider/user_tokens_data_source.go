@@ -0,0 +1,130 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	netbirdApi "github.com/netbirdio/netbird/management/server/http/api"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &UserTokensDataSource{}
+
+func NewUserTokensDataSource() datasource.DataSource {
+	return &UserTokensDataSource{}
+}
+
+// UserTokensDataSource lists the personal access tokens of a user.
+type UserTokensDataSource struct {
+	client ClientInterface
+}
+
+func (d *UserTokensDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user_tokens"
+}
+
+func (d *UserTokensDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Retrieve the personal access tokens of a user, e.g. to find tokens due for rotation. " +
+			"Token secrets are only returned when a token is created and are not available.",
+
+		Attributes: map[string]schema.Attribute{
+			"user_id": schema.StringAttribute{
+				Required:    true,
+				Description: "Unique identifier of the user.",
+			},
+			"tokens": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Personal access tokens of the user.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "Unique identifier of the token.",
+						},
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "Name of the token.",
+						},
+						"created_at": schema.StringAttribute{
+							Computed:    true,
+							Description: "Date the token was created, in RFC 3339 format.",
+						},
+						"expiration_date": schema.StringAttribute{
+							Computed:    true,
+							Description: "Date the token expires, in RFC 3339 format.",
+						},
+						"last_used": schema.StringAttribute{
+							Computed:    true,
+							Description: "Date the token was last used, in RFC 3339 format. Null if the token has never been used.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *UserTokensDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(ClientInterface)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *UserTokensDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data UserTokensDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	path := fmt.Sprintf("/api/users/%s/tokens", data.UserID.ValueString())
+	tokens, err := getJSON[[]netbirdApi.PersonalAccessToken](ctx, d.client, path)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Making API Request", err.Error())
+		return
+	}
+	if tokens == nil {
+		resp.Diagnostics.AddError("User Not Found", "No user found at "+path)
+		return
+	}
+
+	data.Tokens = []UserTokenDataSourceModel{}
+	for _, token := range *tokens {
+		lastUsed := types.StringNull()
+		if token.LastUsed != nil {
+			lastUsed = formatTimestamp(*token.LastUsed)
+		}
+		data.Tokens = append(data.Tokens, UserTokenDataSourceModel{
+			ID:             types.StringValue(token.Id),
+			Name:           types.StringValue(token.Name),
+			CreatedAt:      formatTimestamp(token.CreatedAt),
+			ExpirationDate: formatTimestamp(token.ExpirationDate),
+			LastUsed:       lastUsed,
+		})
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,62 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccUserTokensDataSource(t *testing.T) {
+	testAccMockOnly(t)
+	providerConfig, mock := testAccProviderConfig(t)
+
+	mock.Seed("/api/users/user-1/tokens", map[string]any{
+		"name":            "ci",
+		"created_by":      "user-1",
+		"created_at":      "2025-01-01T00:00:00Z",
+		"expiration_date": "2025-04-01T00:00:00Z",
+		"last_used":       "2025-02-01T12:30:00Z",
+	})
+	mock.Seed("/api/users/user-1/tokens", map[string]any{
+		"name":            "unused",
+		"created_by":      "user-1",
+		"created_at":      "2025-03-01T00:00:00+02:00",
+		"expiration_date": "2026-03-01T00:00:00Z",
+	})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + testAccUserTokensDataSourceConfig("user-1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.netbird_user_tokens.test", "tokens.#", "2"),
+					resource.TestCheckResourceAttrSet("data.netbird_user_tokens.test", "tokens.0.id"),
+					resource.TestCheckResourceAttr("data.netbird_user_tokens.test", "tokens.0.name", "ci"),
+					resource.TestCheckResourceAttr("data.netbird_user_tokens.test", "tokens.0.created_at", "2025-01-01T00:00:00Z"),
+					resource.TestCheckResourceAttr("data.netbird_user_tokens.test", "tokens.0.expiration_date", "2025-04-01T00:00:00Z"),
+					resource.TestCheckResourceAttr("data.netbird_user_tokens.test", "tokens.0.last_used", "2025-02-01T12:30:00Z"),
+					resource.TestCheckResourceAttr("data.netbird_user_tokens.test", "tokens.1.name", "unused"),
+					resource.TestCheckResourceAttr("data.netbird_user_tokens.test", "tokens.1.created_at", "2025-02-28T22:00:00Z"),
+					resource.TestCheckNoResourceAttr("data.netbird_user_tokens.test", "tokens.1.last_used"),
+				),
+			},
+			{
+				Config: providerConfig + testAccUserTokensDataSourceConfig("user-2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.netbird_user_tokens.test", "tokens.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccUserTokensDataSourceConfig(userID string) string {
+	return fmt.Sprintf(`
+data "netbird_user_tokens" "test" {
+  user_id = %q
+}
+`, userID)
+}
@@ -0,0 +1,179 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	netbirdApi "github.com/netbirdio/netbird/management/server/http/api"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &UsersDataSource{}
+
+func NewUsersDataSource() datasource.DataSource {
+	return &UsersDataSource{}
+}
+
+// UsersDataSource lists the users of the account.
+type UsersDataSource struct {
+	client ClientInterface
+}
+
+func (d *UsersDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_users"
+}
+
+func (d *UsersDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Retrieve the users of the account, optionally filtered by role and whether they are service users, e.g. for access reviews. " +
+			"Users must match all given filters.",
+
+		Attributes: map[string]schema.Attribute{
+			"role": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only return users with this role, one of owner, admin, user, billing_admin, auditor or network_admin.",
+				Validators: []validator.String{
+					userRoleValidator,
+				},
+			},
+			"is_service_user": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Only return service users when true, or only regular users when false.",
+			},
+			"ids": schema.ListAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+				Description: "IDs of the users matching the filters.",
+			},
+			"users": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Users matching the filters.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "Unique identifier of the user.",
+						},
+						"email": schema.StringAttribute{
+							Computed:    true,
+							Description: "Email address of the user.",
+						},
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "Name of the user.",
+						},
+						"role": schema.StringAttribute{
+							Computed:    true,
+							Description: "Account role of the user.",
+						},
+						"status": schema.StringAttribute{
+							Computed:    true,
+							Description: "Status of the user, e.g. active or invited.",
+						},
+						"is_service_user": schema.BoolAttribute{
+							Computed:    true,
+							Description: "Indicates whether the user is a service user.",
+						},
+						"is_blocked": schema.BoolAttribute{
+							Computed:    true,
+							Description: "Indicates whether the user is blocked.",
+						},
+						"auto_groups": schema.ListAttribute{
+							ElementType: types.StringType,
+							Computed:    true,
+							Description: "Group IDs auto-assigned to peers registered by the user.",
+						},
+						"last_login": schema.StringAttribute{
+							Computed:    true,
+							Description: "Timestamp of the last login of the user to the dashboard, in RFC 3339 format.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *UsersDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(ClientInterface)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *UsersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data UsersDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	users, err := getJSON[[]netbirdApi.User](ctx, d.client, "/api/users")
+	if err != nil {
+		resp.Diagnostics.AddError("Error Making API Request", err.Error())
+		return
+	}
+
+	data.IDs = []types.String{}
+	data.Users = []UserDataSourceModel{}
+	if users != nil {
+		for _, user := range *users {
+			// The API can't filter by role, so filter client-side
+			if !data.Role.IsNull() && user.Role != data.Role.ValueString() {
+				continue
+			}
+			isServiceUser := user.IsServiceUser != nil && *user.IsServiceUser
+			if !data.IsServiceUser.IsNull() && isServiceUser != data.IsServiceUser.ValueBool() {
+				continue
+			}
+			data.IDs = append(data.IDs, types.StringValue(user.Id))
+			data.Users = append(data.Users, convertUserToDataSourceModel(user))
+		}
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// convertUserToDataSourceModel maps a user returned by the API to its data source model.
+func convertUserToDataSourceModel(user netbirdApi.User) UserDataSourceModel {
+	model := UserDataSourceModel{
+		ID:            types.StringValue(user.Id),
+		Email:         types.StringValue(user.Email),
+		Name:          types.StringValue(user.Name),
+		Role:          types.StringValue(user.Role),
+		Status:        types.StringValue(string(user.Status)),
+		IsServiceUser: types.BoolValue(user.IsServiceUser != nil && *user.IsServiceUser),
+		IsBlocked:     types.BoolValue(user.IsBlocked),
+		AutoGroups:    []types.String{},
+		LastLogin:     types.StringNull(),
+	}
+	for _, group := range user.AutoGroups {
+		model.AutoGroups = append(model.AutoGroups, types.StringValue(group))
+	}
+	if user.LastLogin != nil {
+		model.LastLogin = formatTimestamp(*user.LastLogin)
+	}
+	return model
+}
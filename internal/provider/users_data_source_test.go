@@ -0,0 +1,83 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccUsersDataSource(t *testing.T) {
+	testAccMockOnly(t)
+	providerConfig, mock := testAccProviderConfig(t)
+	ownerID := mock.Seed("/api/users", map[string]any{"email": "owner@example.com", "name": "Owner", "role": "owner", "status": "active", "auto_groups": []string{"group-1"}, "last_login": "2025-03-01T12:05:00Z"})
+	adminID := mock.Seed("/api/users", map[string]any{"email": "admin@example.com", "name": "Admin", "role": "admin", "status": "active", "auto_groups": []string{}})
+	serviceUserID := mock.Seed("/api/users", map[string]any{"name": "CI", "role": "admin", "status": "active", "is_service_user": true, "auto_groups": []string{}})
+	mock.Seed("/api/users", map[string]any{"email": "user@example.com", "name": "User", "role": "user", "status": "invited", "is_blocked": true, "auto_groups": []string{}})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+data "netbird_users" "all" {}
+
+data "netbird_users" "admins" {
+  role = "admin"
+}
+
+data "netbird_users" "service_users" {
+  is_service_user = true
+}
+
+# Filters are combined
+data "netbird_users" "human_admins" {
+  role            = "admin"
+  is_service_user = false
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.netbird_users.all", "ids.#", "4"),
+					resource.TestCheckResourceAttr("data.netbird_users.all", "users.#", "4"),
+					resource.TestCheckResourceAttr("data.netbird_users.all", "users.0.id", ownerID),
+					resource.TestCheckResourceAttr("data.netbird_users.all", "users.0.email", "owner@example.com"),
+					resource.TestCheckResourceAttr("data.netbird_users.all", "users.0.role", "owner"),
+					resource.TestCheckResourceAttr("data.netbird_users.all", "users.0.auto_groups.0", "group-1"),
+					resource.TestCheckResourceAttr("data.netbird_users.all", "users.0.last_login", "2025-03-01T12:05:00Z"),
+					resource.TestCheckResourceAttr("data.netbird_users.all", "users.0.is_service_user", "false"),
+					resource.TestCheckNoResourceAttr("data.netbird_users.all", "users.1.last_login"),
+					resource.TestCheckResourceAttr("data.netbird_users.all", "users.3.status", "invited"),
+					resource.TestCheckResourceAttr("data.netbird_users.all", "users.3.is_blocked", "true"),
+					resource.TestCheckResourceAttr("data.netbird_users.admins", "ids.#", "2"),
+					resource.TestCheckResourceAttr("data.netbird_users.admins", "ids.0", adminID),
+					resource.TestCheckResourceAttr("data.netbird_users.admins", "ids.1", serviceUserID),
+					resource.TestCheckResourceAttr("data.netbird_users.service_users", "ids.#", "1"),
+					resource.TestCheckResourceAttr("data.netbird_users.service_users", "users.0.id", serviceUserID),
+					resource.TestCheckResourceAttr("data.netbird_users.service_users", "users.0.is_service_user", "true"),
+					resource.TestCheckResourceAttr("data.netbird_users.human_admins", "ids.#", "1"),
+					resource.TestCheckResourceAttr("data.netbird_users.human_admins", "ids.0", adminID),
+				),
+			},
+		},
+	})
+}
+
+func TestAccUsersDataSource_invalidRole(t *testing.T) {
+	providerConfig, _ := testAccProviderConfig(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+data "netbird_users" "test" {
+  role = "superuser"
+}
+`,
+				ExpectError: regexp.MustCompile("must be one of owner, admin, user"),
+			},
+		},
+	})
+}
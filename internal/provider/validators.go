@@ -0,0 +1,340 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// domainPattern matches a domain name, optionally prefixed by a `*.` wildcard label.
+var domainPattern = regexp.MustCompile(`^(\*\.)?[a-zA-Z0-9_]([a-zA-Z0-9_-]{0,61}[a-zA-Z0-9_])?(\.[a-zA-Z0-9_]([a-zA-Z0-9_-]{0,61}[a-zA-Z0-9_])?)*$`)
+
+// validDomain reports whether domain is a domain name such as `example.com` or
+// a wildcard such as `*.example.com`.
+func validDomain(domain string) bool {
+	return len(domain) <= 253 && domainPattern.MatchString(domain)
+}
+
+var _ validator.List = domainListValidator{}
+
+// domainListValidator checks every element of a list of strings is a domain or wildcard domain.
+type domainListValidator struct{}
+
+func (v domainListValidator) Description(ctx context.Context) string {
+	return "each value must be a domain such as example.com or a wildcard such as *.example.com"
+}
+
+func (v domainListValidator) MarkdownDescription(ctx context.Context) string {
+	return "each value must be a domain such as `example.com` or a wildcard such as `*.example.com`"
+}
+
+func (v domainListValidator) ValidateList(ctx context.Context, req validator.ListRequest, resp *validator.ListResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	for i, element := range req.ConfigValue.Elements() {
+		domain, ok := element.(types.String)
+		if !ok || domain.IsNull() || domain.IsUnknown() {
+			continue
+		}
+		if !validDomain(domain.ValueString()) {
+			resp.Diagnostics.AddAttributeError(
+				req.Path.AtListIndex(i),
+				"Invalid domain",
+				fmt.Sprintf("%q is not a valid domain: %s.", domain.ValueString(), v.Description(ctx)),
+			)
+		}
+	}
+}
+
+var _ validator.List = cidrListValidator{}
+
+// cidrListValidator checks every element of a list of strings is a network range in CIDR format.
+type cidrListValidator struct{}
+
+func (v cidrListValidator) Description(ctx context.Context) string {
+	return "each value must be a network range in CIDR format such as 10.0.0.0/16"
+}
+
+func (v cidrListValidator) MarkdownDescription(ctx context.Context) string {
+	return "each value must be a network range in CIDR format such as `10.0.0.0/16`"
+}
+
+func (v cidrListValidator) ValidateList(ctx context.Context, req validator.ListRequest, resp *validator.ListResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	for i, element := range req.ConfigValue.Elements() {
+		network, ok := element.(types.String)
+		if !ok || network.IsNull() || network.IsUnknown() {
+			continue
+		}
+		if _, err := netip.ParsePrefix(network.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				req.Path.AtListIndex(i),
+				"Invalid network range",
+				fmt.Sprintf("%q is not a valid network range: %s.", network.ValueString(), v.Description(ctx)),
+			)
+		}
+	}
+}
+
+var _ validator.String = stringRegexValidator{}
+
+// stringRegexValidator checks a string matches pattern, described by message.
+type stringRegexValidator struct {
+	pattern *regexp.Regexp
+	message string
+}
+
+// countryCodeValidator accepts ISO 3166-1 alpha-2 country codes such as `DE`.
+var countryCodeValidator = stringRegexValidator{
+	pattern: regexp.MustCompile(`^[A-Z]{2}$`),
+	message: "must be an ISO 3166-1 alpha-2 country code of exactly 2 uppercase letters, e.g. DE",
+}
+
+// objectIDValidator accepts IDs which can be used as a URL path segment as is,
+// rejecting empty IDs and IDs with whitespace or reserved characters such as `/`.
+var objectIDValidator = stringRegexValidator{
+	pattern: regexp.MustCompile(`^[A-Za-z0-9._~-]+$`),
+	message: "must be a non-empty ID of letters, digits and the characters . _ ~ -",
+}
+
+// routeNetworkTypeValidator accepts the network types of routes.
+var routeNetworkTypeValidator = stringRegexValidator{
+	pattern: regexp.MustCompile(`^(IPv4|IPv6|Domain)$`),
+	message: "must be one of IPv4, IPv6 or Domain",
+}
+
+// groupIssuedValidator accepts the ways a group can be issued.
+var groupIssuedValidator = stringRegexValidator{
+	pattern: regexp.MustCompile(`^(api|jwt|integration)$`),
+	message: "must be one of api, jwt or integration",
+}
+
+// userRoleValidator accepts the account roles of users.
+var userRoleValidator = stringRegexValidator{
+	pattern: regexp.MustCompile(`^(owner|admin|user|billing_admin|auditor|network_admin)$`),
+	message: "must be one of owner, admin, user, billing_admin, auditor or network_admin",
+}
+
+// processPathValidator accepts paths to process executables, rejecting empty paths.
+var processPathValidator = stringRegexValidator{
+	pattern: regexp.MustCompile(`\S`),
+	message: "must be a non-empty path to an executable",
+}
+
+// cityNameValidator accepts city names of geo location checks, rejecting empty names.
+var cityNameValidator = stringRegexValidator{
+	pattern: regexp.MustCompile(`\S`),
+	message: "must be a non-empty city name, leave it unset to match the whole country",
+}
+
+// postureCheckActionValidator accepts the actions of posture checks matching peers.
+var postureCheckActionValidator = stringRegexValidator{
+	pattern: regexp.MustCompile(`^(allow|deny)$`),
+	message: "must be either allow or deny",
+}
+
+func (v stringRegexValidator) Description(ctx context.Context) string {
+	return "value " + v.message
+}
+
+func (v stringRegexValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v stringRegexValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if !v.pattern.MatchString(req.ConfigValue.ValueString()) {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid value",
+			fmt.Sprintf("%q is invalid: value %s.", req.ConfigValue.ValueString(), v.message),
+		)
+	}
+}
+
+var _ validator.String = versionValidator{}
+
+// versionValidator checks a string is a semantic version such as `0.43.0`.
+type versionValidator struct{}
+
+func (v versionValidator) Description(ctx context.Context) string {
+	return "value must be a semantic version such as 0.43.0"
+}
+
+func (v versionValidator) MarkdownDescription(ctx context.Context) string {
+	return "value must be a semantic version such as `0.43.0`"
+}
+
+func (v versionValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if _, err := semver.NewVersion(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid version",
+			fmt.Sprintf("%q is invalid: %s.", req.ConfigValue.ValueString(), v.Description(ctx)),
+		)
+	}
+}
+
+var _ validator.Int64 = int64AtLeastValidator{}
+
+// int64AtLeastValidator checks an integer is no less than min.
+type int64AtLeastValidator struct {
+	min int64
+}
+
+func (v int64AtLeastValidator) Description(ctx context.Context) string {
+	return fmt.Sprintf("value must be at least %d", v.min)
+}
+
+func (v int64AtLeastValidator) MarkdownDescription(ctx context.Context) string {
+	return fmt.Sprintf("value must be at least `%d`", v.min)
+}
+
+func (v int64AtLeastValidator) ValidateInt64(ctx context.Context, req validator.Int64Request, resp *validator.Int64Response) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if req.ConfigValue.ValueInt64() < v.min {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid value",
+			fmt.Sprintf("%d is invalid: %s.", req.ConfigValue.ValueInt64(), v.Description(ctx)),
+		)
+	}
+}
+
+// parsePort parses a single port number between 1 and 65535.
+func parsePort(value string) (int, error) {
+	port, err := strconv.Atoi(value)
+	if err != nil || strings.HasPrefix(value, "+") {
+		return 0, fmt.Errorf("%q is not a port number", value)
+	}
+	if port < 1 || port > 65535 {
+		return 0, fmt.Errorf("port %d is out of range, ports must be between 1 and 65535", port)
+	}
+	return port, nil
+}
+
+// parsePortRange parses a single port such as `443` or an inclusive range such as `8000-8010`.
+func parsePortRange(value string) (int, int, error) {
+	startValue, endValue, isRange := strings.Cut(value, "-")
+	start, err := parsePort(startValue)
+	if err != nil {
+		return 0, 0, err
+	}
+	if !isRange {
+		return start, start, nil
+	}
+	end, err := parsePort(endValue)
+	if err != nil {
+		return 0, 0, err
+	}
+	if end < start {
+		return 0, 0, fmt.Errorf("port range %q is inverted, the start must not be greater than the end", value)
+	}
+	return start, end, nil
+}
+
+var _ validator.List = portListValidator{}
+
+// portListValidator checks every element of a list of strings is a single port number.
+type portListValidator struct{}
+
+func (v portListValidator) Description(ctx context.Context) string {
+	return "each value must be a port number between 1 and 65535, use port_ranges for ranges"
+}
+
+func (v portListValidator) MarkdownDescription(ctx context.Context) string {
+	return "each value must be a port number between 1 and 65535, use `port_ranges` for ranges"
+}
+
+func (v portListValidator) ValidateList(ctx context.Context, req validator.ListRequest, resp *validator.ListResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	for i, element := range req.ConfigValue.Elements() {
+		port, ok := element.(types.String)
+		if !ok || port.IsNull() || port.IsUnknown() {
+			continue
+		}
+		if _, err := parsePort(port.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				req.Path.AtListIndex(i),
+				"Invalid port",
+				fmt.Sprintf("%s: %s.", err, v.Description(ctx)),
+			)
+		}
+	}
+}
+
+var _ validator.List = processListValidator{}
+
+// processListValidator checks a list of processes of a process check is not
+// empty and every process has a path for at least one operating system.
+type processListValidator struct{}
+
+func (v processListValidator) Description(ctx context.Context) string {
+	return "at least one process must be set, each with at least one of linux_path, mac_path or windows_path"
+}
+
+func (v processListValidator) MarkdownDescription(ctx context.Context) string {
+	return "at least one process must be set, each with at least one of `linux_path`, `mac_path` or `windows_path`"
+}
+
+func (v processListValidator) ValidateList(ctx context.Context, req validator.ListRequest, resp *validator.ListResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if len(req.ConfigValue.Elements()) == 0 {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Missing process",
+			fmt.Sprintf("A process check must check at least one process: %s.", v.Description(ctx)),
+		)
+		return
+	}
+
+	for i, element := range req.ConfigValue.Elements() {
+		process, ok := element.(types.Object)
+		if !ok || process.IsNull() || process.IsUnknown() {
+			continue
+		}
+
+		hasPath := false
+		for _, name := range []string{"linux_path", "mac_path", "windows_path"} {
+			// Paths may not be known until apply
+			if value, ok := process.Attributes()[name]; ok && !value.IsNull() {
+				hasPath = true
+			}
+		}
+		if !hasPath {
+			resp.Diagnostics.AddAttributeError(
+				req.Path.AtListIndex(i),
+				"Missing process path",
+				fmt.Sprintf("The process has no path: %s.", v.Description(ctx)),
+			)
+		}
+	}
+}
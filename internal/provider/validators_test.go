@@ -0,0 +1,168 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestValidDomain(t *testing.T) {
+	for _, domain := range []string{"example.com", "*.example.com", "sub.example.co.uk", "*.internal", "local", "in-addr.arpa", "_tcp.example.com"} {
+		if !validDomain(domain) {
+			t.Errorf("expected %q to be valid", domain)
+		}
+	}
+	for _, domain := range []string{"", "*", "*.", "*example.com", "example..com", ".example.com", "example.com.", "-example.com", "example-.com", "foo.*.example.com", "exa mple.com"} {
+		if validDomain(domain) {
+			t.Errorf("expected %q to be invalid", domain)
+		}
+	}
+}
+
+func TestDomainListValidator(t *testing.T) {
+	list := types.ListValueMust(types.StringType, []attr.Value{
+		types.StringValue("*.example.com"),
+		types.StringValue("*example.com"),
+	})
+	req := validator.ListRequest{Path: path.Root("domains"), ConfigValue: list}
+	resp := &validator.ListResponse{}
+
+	domainListValidator{}.ValidateList(context.Background(), req, resp)
+
+	if resp.Diagnostics.ErrorsCount() != 1 {
+		t.Fatalf("expected 1 error, got %d: %v", resp.Diagnostics.ErrorsCount(), resp.Diagnostics)
+	}
+	if got := resp.Diagnostics.Errors()[0].(diag.DiagnosticWithPath).Path(); !got.Equal(path.Root("domains").AtListIndex(1)) {
+		t.Errorf("expected error on domains[1], got %s", got)
+	}
+}
+
+func TestCIDRListValidator(t *testing.T) {
+	list := types.ListValueMust(types.StringType, []attr.Value{
+		types.StringValue("192.168.0.0/16"),
+		types.StringValue("fd00::/8"),
+		types.StringValue("192.168.0.1"),
+	})
+	req := validator.ListRequest{Path: path.Root("ranges"), ConfigValue: list}
+	resp := &validator.ListResponse{}
+
+	cidrListValidator{}.ValidateList(context.Background(), req, resp)
+
+	if resp.Diagnostics.ErrorsCount() != 1 {
+		t.Fatalf("expected 1 error, got %d: %v", resp.Diagnostics.ErrorsCount(), resp.Diagnostics)
+	}
+	if got := resp.Diagnostics.Errors()[0].(diag.DiagnosticWithPath).Path(); !got.Equal(path.Root("ranges").AtListIndex(2)) {
+		t.Errorf("expected error on ranges[2], got %s", got)
+	}
+}
+
+func TestCountryCodeValidator(t *testing.T) {
+	for value, valid := range map[string]bool{"DE": true, "GB": true, "de": false, "DEU": false, "D": false, "": false} {
+		req := validator.StringRequest{Path: path.Root("country_code"), ConfigValue: types.StringValue(value)}
+		resp := &validator.StringResponse{}
+
+		countryCodeValidator.ValidateString(context.Background(), req, resp)
+
+		if resp.Diagnostics.HasError() == valid {
+			t.Errorf("%q: expected valid=%t, got diagnostics %v", value, valid, resp.Diagnostics)
+		}
+	}
+}
+
+func TestObjectIDValidator(t *testing.T) {
+	for value, valid := range map[string]bool{
+		"cv2ch4bo2nb6pd2cb2sg":                 true,
+		"5c5d5a2e-3e4e-4f0a-9d8b-1f2e3d4c5b6a": true,
+		"":                                     false,
+		"peer 1":                               false,
+		" cv2ch4bo2nb6pd2cb2sg":                false,
+		"../groups":                            false,
+		"peer?id=1":                            false,
+	} {
+		req := validator.StringRequest{Path: path.Root("id"), ConfigValue: types.StringValue(value)}
+		resp := &validator.StringResponse{}
+
+		objectIDValidator.ValidateString(context.Background(), req, resp)
+
+		if resp.Diagnostics.HasError() == valid {
+			t.Errorf("%q: expected valid=%t, got diagnostics %v", value, valid, resp.Diagnostics)
+		}
+	}
+}
+
+func TestInt64AtLeastValidator(t *testing.T) {
+	for value, valid := range map[int64]bool{-1: false, 0: true, 86400: true} {
+		req := validator.Int64Request{Path: path.Root("expires_in"), ConfigValue: types.Int64Value(value)}
+		resp := &validator.Int64Response{}
+
+		int64AtLeastValidator{min: 0}.ValidateInt64(context.Background(), req, resp)
+
+		if resp.Diagnostics.HasError() == valid {
+			t.Errorf("%d: expected valid=%t, got diagnostics %v", value, valid, resp.Diagnostics)
+		}
+	}
+}
+
+func TestPortListValidator(t *testing.T) {
+	list := types.ListValueMust(types.StringType, []attr.Value{
+		types.StringValue("443"),
+		types.StringValue("8000-8010"),
+		types.StringValue("70000"),
+	})
+	req := validator.ListRequest{Path: path.Root("ports"), ConfigValue: list}
+	resp := &validator.ListResponse{}
+
+	portListValidator{}.ValidateList(context.Background(), req, resp)
+
+	if resp.Diagnostics.ErrorsCount() != 2 {
+		t.Fatalf("expected 2 errors, got %d: %v", resp.Diagnostics.ErrorsCount(), resp.Diagnostics)
+	}
+	if got := resp.Diagnostics.Errors()[0].(diag.DiagnosticWithPath).Path(); !got.Equal(path.Root("ports").AtListIndex(1)) {
+		t.Errorf("expected error at index 1, got %s", got)
+	}
+}
+
+func TestProcessListValidator(t *testing.T) {
+	processType := map[string]attr.Type{
+		"linux_path":   types.StringType,
+		"mac_path":     types.StringType,
+		"windows_path": types.StringType,
+	}
+	process := func(linuxPath, macPath, windowsPath types.String) attr.Value {
+		return types.ObjectValueMust(processType, map[string]attr.Value{
+			"linux_path":   linuxPath,
+			"mac_path":     macPath,
+			"windows_path": windowsPath,
+		})
+	}
+	list := types.ListValueMust(types.ObjectType{AttrTypes: processType}, []attr.Value{
+		process(types.StringValue("/usr/bin/falcon-sensor"), types.StringNull(), types.StringNull()),
+		process(types.StringNull(), types.StringNull(), types.StringNull()),
+		process(types.StringNull(), types.StringNull(), types.StringUnknown()),
+	})
+	req := validator.ListRequest{Path: path.Root("processes"), ConfigValue: list}
+	resp := &validator.ListResponse{}
+
+	processListValidator{}.ValidateList(context.Background(), req, resp)
+
+	if resp.Diagnostics.ErrorsCount() != 1 {
+		t.Fatalf("expected 1 error, got %d: %v", resp.Diagnostics.ErrorsCount(), resp.Diagnostics)
+	}
+	if got := resp.Diagnostics.Errors()[0].(diag.DiagnosticWithPath).Path(); !got.Equal(path.Root("processes").AtListIndex(1)) {
+		t.Errorf("expected error on processes[1], got %s", got)
+	}
+
+	req.ConfigValue = types.ListValueMust(types.ObjectType{AttrTypes: processType}, []attr.Value{})
+	resp = &validator.ListResponse{}
+
+	processListValidator{}.ValidateList(context.Background(), req, resp)
+
+	if resp.Diagnostics.ErrorsCount() != 1 {
+		t.Fatalf("expected 1 error for an empty list, got %d: %v", resp.Diagnostics.ErrorsCount(), resp.Diagnostics)
+	}
+}
@@ -0,0 +1,510 @@
+// Package testutils contains helpers shared by the provider tests.
+package testutils
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"maps"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// MockToken is the access token accepted by the mock server.
+const MockToken = "nbp_mock_token"
+
+// renderFunc converts a stored object into the shape returned by the API.
+type renderFunc func(m *MockServer, path string, obj map[string]any) map[string]any
+
+type collection struct {
+	// pattern is the collection path, where `*` matches a single path segment.
+	pattern string
+	// partialUpdate merges PUT bodies into the stored object instead of replacing it.
+	partialUpdate bool
+	render        renderFunc
+}
+
+// MockServer is an in-memory fake of the NetBird management API, backed by
+// httptest.Server. Objects created through POST are stored as-is and rendered
+// into the API response shape on the way out, so the provider sees the same
+// payloads it would receive from a real server.
+type MockServer struct {
+	*httptest.Server
+
+	mu          sync.Mutex
+	nextID      int
+	collections []collection
+	objects     map[string]map[string]map[string]any
+	order       map[string][]string
+	singletons  map[string]map[string]any
+}
+
+// NewMockServer starts a mock NetBird API which is closed when the test finishes.
+func NewMockServer(t *testing.T) *MockServer {
+	t.Helper()
+
+	m := &MockServer{
+		objects: map[string]map[string]map[string]any{},
+		order:   map[string][]string{},
+		singletons: map[string]map[string]any{
+			"/api/dns/settings": {"disabled_management_groups": []any{}},
+		},
+	}
+	m.collections = []collection{
+		{pattern: "/api/groups", render: renderGroup},
+		{pattern: "/api/policies", render: renderPolicy},
+		{pattern: "/api/posture-checks"},
+		{pattern: "/api/networks", render: renderNetwork},
+		{pattern: "/api/networks/*/routers"},
+		{pattern: "/api/networks/*/resources", render: renderNetworkResource},
+		{pattern: "/api/dns/nameservers"},
+		{pattern: "/api/routes", render: renderRoute},
+		{pattern: "/api/users/*/tokens"},
+		{pattern: "/api/users"},
+		{pattern: "/api/peers", partialUpdate: true, render: renderPeer},
+		{pattern: "/api/accounts", partialUpdate: true},
+		{pattern: "/api/setup-keys", partialUpdate: true, render: renderSetupKey},
+	}
+	m.store("/api/accounts", map[string]any{
+		"id":     "mockaccount",
+		"domain": "example.com",
+		"settings": map[string]any{
+			"peer_login_expiration_enabled":      true,
+			"peer_login_expiration":              86400,
+			"peer_inactivity_expiration_enabled": false,
+			"peer_inactivity_expiration":         600,
+			"regular_users_view_blocked":         true,
+		},
+	})
+	m.Server = httptest.NewServer(http.HandlerFunc(m.handle))
+	t.Cleanup(m.Close)
+
+	return m
+}
+
+// Seed stores obj in the collection at path and returns its ID. Objects
+// without an `id` field are assigned one.
+func (m *MockServer) Seed(path string, obj any) string {
+	raw, err := json.Marshal(obj)
+	if err != nil {
+		panic(err)
+	}
+	var stored map[string]any
+	if err := json.Unmarshal(raw, &stored); err != nil {
+		panic(err)
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.store(path, stored)
+}
+
+// Exists reports whether an object with the given ID is stored in the
+// collection at path.
+func (m *MockServer) Exists(path string, id string) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	_, ok := m.objects[path][id]
+	return ok
+}
+
+// Get returns a copy of the stored fields of the object with the given ID in
+// the collection at path, or nil if there is none.
+func (m *MockServer) Get(path string, id string) map[string]any {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	obj, ok := m.objects[path][id]
+	if !ok {
+		return nil
+	}
+	return maps.Clone(obj)
+}
+
+// Delete removes the object with the given ID from the collection at path,
+// as if it was deleted outside of Terraform.
+func (m *MockServer) Delete(path string, id string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.remove(path, id)
+}
+
+func (m *MockServer) store(path string, obj map[string]any) string {
+	id, _ := obj["id"].(string)
+	if id == "" {
+		m.nextID++
+		id = fmt.Sprintf("mock%04d", m.nextID)
+		obj["id"] = id
+	}
+	if m.objects[path] == nil {
+		m.objects[path] = map[string]map[string]any{}
+	}
+	if _, exists := m.objects[path][id]; !exists {
+		m.order[path] = append(m.order[path], id)
+	}
+	m.objects[path][id] = obj
+	return id
+}
+
+func (m *MockServer) remove(path string, id string) {
+	delete(m.objects[path], id)
+	ids := m.order[path]
+	for i, existing := range ids {
+		if existing == id {
+			m.order[path] = append(ids[:i:i], ids[i+1:]...)
+			break
+		}
+	}
+}
+
+func (m *MockServer) handle(w http.ResponseWriter, r *http.Request) {
+	auth := r.Header.Get("Authorization")
+	if auth != "Token "+MockToken && auth != "Bearer "+MockToken {
+		writeError(w, http.StatusUnauthorized, "token invalid")
+		return
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	path := strings.TrimSuffix(r.URL.Path, "/")
+
+	if singleton, ok := m.singletons[path]; ok {
+		switch r.Method {
+		case http.MethodGet:
+			writeJSON(w, http.StatusOK, singleton)
+		case http.MethodPut:
+			body, ok := readBody(w, r)
+			if !ok {
+				return
+			}
+			m.singletons[path] = body
+			writeJSON(w, http.StatusOK, body)
+		default:
+			writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		}
+		return
+	}
+
+	for _, c := range m.collections {
+		if matchPath(c.pattern, path) {
+			m.handleCollection(w, r, c, path)
+			return
+		}
+		if parent, id := splitItem(path); id != "" && matchPath(c.pattern, parent) {
+			m.handleItem(w, r, c, parent, id)
+			return
+		}
+	}
+
+	writeError(w, http.StatusNotFound, "no route for "+path)
+}
+
+func (m *MockServer) handleCollection(w http.ResponseWriter, r *http.Request, c collection, path string) {
+	switch r.Method {
+	case http.MethodGet:
+		items := []map[string]any{}
+		for _, id := range m.order[path] {
+			obj := m.render(c, path, m.objects[path][id])
+			if matchQuery(obj, r) {
+				items = append(items, obj)
+			}
+		}
+		writeJSON(w, http.StatusOK, items)
+	case http.MethodPost:
+		body, ok := readBody(w, r)
+		if !ok {
+			return
+		}
+		delete(body, "id")
+		m.store(path, body)
+		writeJSON(w, http.StatusOK, m.render(c, path, body))
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+	}
+}
+
+func (m *MockServer) handleItem(w http.ResponseWriter, r *http.Request, c collection, path string, id string) {
+	obj, ok := m.objects[path][id]
+	if !ok {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("object %s not found", id))
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		writeJSON(w, http.StatusOK, m.render(c, path, obj))
+	case http.MethodPut:
+		body, ok := readBody(w, r)
+		if !ok {
+			return
+		}
+		if c.partialUpdate {
+			for key, value := range body {
+				obj[key] = value
+			}
+			body = obj
+		}
+		body["id"] = id
+		m.objects[path][id] = body
+		writeJSON(w, http.StatusOK, m.render(c, path, body))
+	case http.MethodDelete:
+		m.remove(path, id)
+		writeJSON(w, http.StatusOK, map[string]any{})
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+	}
+}
+
+func (m *MockServer) render(c collection, path string, obj map[string]any) map[string]any {
+	out := make(map[string]any, len(obj))
+	for key, value := range obj {
+		out[key] = value
+	}
+	if c.render != nil {
+		out = c.render(m, path, out)
+	}
+	return out
+}
+
+// groupMinimums expands a list of group IDs into GroupMinimum objects.
+func (m *MockServer) groupMinimums(ids any) []map[string]any {
+	groups := []map[string]any{}
+	list, _ := ids.([]any)
+	for _, raw := range list {
+		id, _ := raw.(string)
+		group := map[string]any{"id": id, "name": "", "peers_count": 0, "resources_count": 0, "issued": "api"}
+		if stored, ok := m.objects["/api/groups"][id]; ok {
+			group["name"] = stored["name"]
+			peers, _ := stored["peers"].([]any)
+			resources, _ := stored["resources"].([]any)
+			group["peers_count"] = len(peers)
+			group["resources_count"] = len(resources)
+		}
+		groups = append(groups, group)
+	}
+	return groups
+}
+
+func renderGroup(m *MockServer, _ string, obj map[string]any) map[string]any {
+	peers := []map[string]any{}
+	ids, _ := obj["peers"].([]any)
+	for _, raw := range ids {
+		id, _ := raw.(string)
+		name := ""
+		if peer, ok := m.objects["/api/peers"][id]; ok {
+			name, _ = peer["name"].(string)
+		}
+		peers = append(peers, map[string]any{"id": id, "name": name})
+	}
+	resources, _ := obj["resources"].([]any)
+	if resources == nil {
+		resources = []any{}
+	}
+
+	obj["peers"] = peers
+	obj["resources"] = resources
+	obj["peers_count"] = len(peers)
+	obj["resources_count"] = len(resources)
+	if _, ok := obj["issued"]; !ok {
+		obj["issued"] = "api"
+	}
+	return obj
+}
+
+func renderPolicy(m *MockServer, _ string, obj map[string]any) map[string]any {
+	if obj["source_posture_checks"] == nil {
+		obj["source_posture_checks"] = []any{}
+	}
+	rules, _ := obj["rules"].([]any)
+	rendered := make([]any, 0, len(rules))
+	for i, raw := range rules {
+		rule, _ := raw.(map[string]any)
+		out := make(map[string]any, len(rule))
+		for key, value := range rule {
+			out[key] = value
+		}
+		if _, ok := out["id"]; !ok {
+			out["id"] = fmt.Sprintf("%s-rule%d", obj["id"], i)
+		}
+		if sources, ok := out["sources"]; ok {
+			out["sources"] = m.groupMinimums(sources)
+		}
+		if destinations, ok := out["destinations"]; ok {
+			out["destinations"] = m.groupMinimums(destinations)
+		}
+		rendered = append(rendered, out)
+	}
+	obj["rules"] = rendered
+	return obj
+}
+
+func renderNetwork(m *MockServer, _ string, obj map[string]any) map[string]any {
+	id, _ := obj["id"].(string)
+	routers := append([]string{}, m.order["/api/networks/"+id+"/routers"]...)
+	resources := append([]string{}, m.order["/api/networks/"+id+"/resources"]...)
+
+	obj["routers"] = routers
+	obj["resources"] = resources
+	obj["policies"] = m.policiesReferencingResources(resources)
+	obj["routing_peers_count"] = len(routers)
+	return obj
+}
+
+// policiesReferencingResources returns the IDs of policies with a rule whose
+// source or destination resource is one of resourceIDs, in creation order.
+func (m *MockServer) policiesReferencingResources(resourceIDs []string) []string {
+	policies := []string{}
+	for _, id := range m.order["/api/policies"] {
+		rules, _ := m.objects["/api/policies"][id]["rules"].([]any)
+		for _, raw := range rules {
+			rule, _ := raw.(map[string]any)
+			if referencesResource(rule["sourceResource"], resourceIDs) || referencesResource(rule["destinationResource"], resourceIDs) {
+				policies = append(policies, id)
+				break
+			}
+		}
+	}
+	return policies
+}
+
+func referencesResource(raw any, resourceIDs []string) bool {
+	resource, _ := raw.(map[string]any)
+	id, _ := resource["id"].(string)
+	return id != "" && slices.Contains(resourceIDs, id)
+}
+
+func renderNetworkResource(m *MockServer, _ string, obj map[string]any) map[string]any {
+	obj["groups"] = m.groupMinimums(obj["groups"])
+	address, _ := obj["address"].(string)
+	obj["type"] = addressType(address)
+	return obj
+}
+
+func renderPeer(m *MockServer, _ string, obj map[string]any) map[string]any {
+	groups := []map[string]any{}
+	for _, id := range m.order["/api/groups"] {
+		peers, _ := m.objects["/api/groups"][id]["peers"].([]any)
+		for _, peer := range peers {
+			if peer == obj["id"] {
+				groups = append(groups, m.groupMinimums([]any{id})...)
+			}
+		}
+	}
+	obj["groups"] = groups
+	return obj
+}
+
+func renderSetupKey(_ *MockServer, _ string, obj map[string]any) map[string]any {
+	id, _ := obj["id"].(string)
+	revoked, _ := obj["revoked"].(bool)
+	obj["key"] = "MOCK-SETUP-KEY-" + id
+	obj["revoked"] = revoked
+	obj["valid"] = !revoked
+	obj["state"] = "valid"
+	if revoked {
+		obj["state"] = "revoked"
+	}
+	obj["expires"] = "2030-01-01T00:00:00Z"
+	obj["last_used"] = "0001-01-01T00:00:00Z"
+	obj["updated_at"] = "2025-01-01T00:00:00Z"
+	obj["used_times"] = 0
+	delete(obj, "expires_in")
+	for _, key := range []string{"ephemeral", "allow_extra_dns_labels"} {
+		if _, ok := obj[key]; !ok {
+			obj[key] = false
+		}
+	}
+	return obj
+}
+
+// renderRoute fills in the network type the server derives from a route's destination.
+func renderRoute(_ *MockServer, _ string, obj map[string]any) map[string]any {
+	if domains, _ := obj["domains"].([]any); len(domains) > 0 {
+		// The server formats the unset network of domain routes as this string
+		obj["network"] = "invalid Prefix"
+		obj["network_type"] = "Domain"
+		return obj
+	}
+	obj["domains"] = []any{}
+	obj["network_type"] = "IPv4"
+	if network, _ := obj["network"].(string); strings.Contains(network, ":") {
+		obj["network_type"] = "IPv6"
+	}
+	return obj
+}
+
+// addressType mirrors the server-side classification of network resource addresses.
+func addressType(address string) string {
+	if _, ipNet, err := net.ParseCIDR(address); err == nil {
+		if ones, bits := ipNet.Mask.Size(); ones == bits {
+			return "host"
+		}
+		return "subnet"
+	}
+	if net.ParseIP(address) != nil {
+		return "host"
+	}
+	return "domain"
+}
+
+func matchPath(pattern string, path string) bool {
+	patternParts := strings.Split(pattern, "/")
+	pathParts := strings.Split(path, "/")
+	if len(patternParts) != len(pathParts) {
+		return false
+	}
+	for i, part := range patternParts {
+		if part != "*" && part != pathParts[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func splitItem(path string) (string, string) {
+	idx := strings.LastIndex(path, "/")
+	if idx <= 0 {
+		return "", ""
+	}
+	return path[:idx], path[idx+1:]
+}
+
+// matchQuery filters list results on query parameters matching top-level string fields.
+func matchQuery(obj map[string]any, r *http.Request) bool {
+	for key, values := range r.URL.Query() {
+		value, ok := obj[key].(string)
+		if !ok || value != values[0] {
+			return false
+		}
+	}
+	return true
+}
+
+func readBody(w http.ResponseWriter, r *http.Request) (map[string]any, bool) {
+	raw, err := io.ReadAll(r.Body)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return nil, false
+	}
+	body := map[string]any{}
+	if err := json.Unmarshal(raw, &body); err != nil {
+		writeError(w, http.StatusBadRequest, "couldn't parse JSON request")
+		return nil, false
+	}
+	return body, true
+}
+
+func writeJSON(w http.ResponseWriter, status int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+func writeError(w http.ResponseWriter, status int, message string) {
+	writeJSON(w, status, map[string]any{"message": message, "code": status})
+}
@@ -0,0 +1,120 @@
+package testutils
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"testing"
+)
+
+func mockRequest(t *testing.T, m *MockServer, method string, path string, body any) (int, map[string]any) {
+	t.Helper()
+
+	var payload []byte
+	if body != nil {
+		var err error
+		if payload, err = json.Marshal(body); err != nil {
+			t.Fatal(err)
+		}
+	}
+	req, err := http.NewRequest(method, m.URL+path, bytes.NewReader(payload))
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Header.Set("Authorization", "Token "+MockToken)
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+
+	var decoded map[string]any
+	_ = json.NewDecoder(resp.Body).Decode(&decoded)
+	return resp.StatusCode, decoded
+}
+
+func TestMockServerCRUD(t *testing.T) {
+	m := NewMockServer(t)
+	peerID := m.Seed("/api/peers", map[string]any{"name": "peer-a"})
+
+	status, created := mockRequest(t, m, http.MethodPost, "/api/groups", map[string]any{"name": "group", "peers": []string{peerID}})
+	if status != http.StatusOK {
+		t.Fatalf("create returned %d", status)
+	}
+	id, _ := created["id"].(string)
+	if id == "" {
+		t.Fatal("created group has no id")
+	}
+	peers, _ := created["peers"].([]any)
+	if len(peers) != 1 || peers[0].(map[string]any)["name"] != "peer-a" {
+		t.Fatalf("unexpected peers in response: %v", created["peers"])
+	}
+
+	status, updated := mockRequest(t, m, http.MethodPut, "/api/groups/"+id, map[string]any{"name": "renamed"})
+	if status != http.StatusOK || updated["name"] != "renamed" || updated["peers_count"] != float64(0) {
+		t.Fatalf("unexpected update response %d: %v", status, updated)
+	}
+
+	if status, _ := mockRequest(t, m, http.MethodDelete, "/api/groups/"+id, nil); status != http.StatusOK {
+		t.Fatalf("delete returned %d", status)
+	}
+	if m.Exists("/api/groups", id) {
+		t.Fatal("group still exists after delete")
+	}
+	if status, body := mockRequest(t, m, http.MethodGet, "/api/groups/"+id, nil); status != http.StatusNotFound || body["code"] != float64(404) {
+		t.Fatalf("expected 404 for deleted group, got %d: %v", status, body)
+	}
+}
+
+func TestMockServerNestedCollections(t *testing.T) {
+	m := NewMockServer(t)
+
+	_, network := mockRequest(t, m, http.MethodPost, "/api/networks", map[string]any{"name": "net"})
+	networkID := network["id"].(string)
+
+	_, resource := mockRequest(t, m, http.MethodPost, "/api/networks/"+networkID+"/resources", map[string]any{"name": "res", "address": "10.0.0.0/24", "groups": []string{}})
+	if resource["type"] != "subnet" {
+		t.Fatalf("expected subnet resource, got %v", resource["type"])
+	}
+
+	_, network = mockRequest(t, m, http.MethodGet, "/api/networks/"+networkID, nil)
+	resources, _ := network["resources"].([]any)
+	if len(resources) != 1 || resources[0] != resource["id"] {
+		t.Fatalf("network does not list its resource: %v", network["resources"])
+	}
+}
+
+func TestMockServerRequiresToken(t *testing.T) {
+	m := NewMockServer(t)
+
+	req, err := http.NewRequest(http.MethodGet, m.URL+"/api/groups", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusUnauthorized {
+		t.Fatalf("expected 401 without token, got %d", resp.StatusCode)
+	}
+}
+
+func TestAddressType(t *testing.T) {
+	for address, expected := range map[string]string{
+		"10.0.0.1":       "host",
+		"10.0.0.1/32":    "host",
+		"10.0.0.0/24":    "subnet",
+		"example.com":    "domain",
+		"*.example.com":  "domain",
+		"2001:db8::/64":  "subnet",
+		"2001:db8::1":    "host",
+		"2001:db8::/128": "host",
+	} {
+		if actual := addressType(address); actual != expected {
+			t.Errorf("addressType(%q) = %q, expected %q", address, actual, expected)
+		}
+	}
+}